@@ -0,0 +1,30 @@
+package v1beta1
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const providerIDPrefix = "scaleway://instance/"
+
+// ParseProviderID parses a provider ID (scaleway://instance/<zone>/<id>) and
+// returns the zone and the ID of the server.
+func ParseProviderID(providerID string) (scw.Zone, string, error) {
+	if !strings.HasPrefix(providerID, providerIDPrefix) {
+		return "", "", fmt.Errorf("invalid provider ID %q: must start with %q", providerID, providerIDPrefix)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(providerID, providerIDPrefix), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid provider ID %q: must be in the format %s<zone>/<id>", providerID, providerIDPrefix)
+	}
+
+	zone, err := scw.ParseZone(parts[0])
+	if err != nil {
+		return "", "", fmt.Errorf("invalid provider ID %q: %w", providerID, err)
+	}
+
+	return zone, parts[1], nil
+}
@@ -0,0 +1,62 @@
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestParseProviderID(t *testing.T) {
+	tests := []struct {
+		name       string
+		providerID string
+		wantZone   scw.Zone
+		wantID     string
+		wantErr    bool
+	}{
+		{
+			name:       "valid provider ID",
+			providerID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+			wantZone:   scw.ZoneFrPar1,
+			wantID:     "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			name:       "invalid prefix",
+			providerID: "aws://instance/fr-par-1/11111111-1111-1111-1111-111111111111",
+			wantErr:    true,
+		},
+		{
+			name:       "missing ID",
+			providerID: "scaleway://instance/fr-par-1/",
+			wantErr:    true,
+		},
+		{
+			name:       "missing zone",
+			providerID: "scaleway://instance//11111111-1111-1111-1111-111111111111",
+			wantErr:    true,
+		},
+		{
+			name:       "too many parts",
+			providerID: "scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111/extra",
+			wantErr:    true,
+		},
+		{
+			name:       "invalid zone",
+			providerID: "scaleway://instance/not-a-zone/11111111-1111-1111-1111-111111111111",
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zone, id, err := ParseProviderID(tt.providerID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseProviderID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if zone != tt.wantZone || id != tt.wantID {
+				t.Errorf("ParseProviderID() = %s, %s, want %s, %s", zone, id, tt.wantZone, tt.wantID)
+			}
+		})
+	}
+}
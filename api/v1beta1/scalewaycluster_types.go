@@ -1,6 +1,7 @@
 package v1beta1
 
 import (
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -13,16 +14,53 @@ import (
 
 const ClusterFinalizer = "scalewaycluster.infrastructure.cluster.x-k8s.io"
 
+// FailureDomain is a zone where machines of the cluster can be created. For
+// backward compatibility, the zone can also be set as a plain string.
+// +kubebuilder:validation:Type=""
+// +kubebuilder:validation:XPreserveUnknownFields
+type FailureDomain struct {
+	// Zone of the failure domain.
+	Zone string `json:"zone"`
+
+	// ControlPlane is false if control-plane machines must not be created in
+	// this failure domain, for example when the zone is reserved for workers.
+	// Defaults to true.
+	// +optional
+	ControlPlane *bool `json:"controlPlane,omitempty"`
+
+	// Attributes are added to the attributes of the failure domain in the
+	// status. They take precedence over the attributes that are discovered
+	// from the Scaleway API.
+	// +optional
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// UnmarshalJSON decodes a failure domain from an object or from the plain zone
+// string that was used by previous versions of the API.
+func (f *FailureDomain) UnmarshalJSON(data []byte) error {
+	var zone string
+	if err := json.Unmarshal(data, &zone); err == nil {
+		*f = FailureDomain{Zone: zone}
+		return nil
+	}
+
+	// The alias has no UnmarshalJSON method, which prevents an infinite
+	// recursion.
+	type failureDomain FailureDomain
+
+	return json.Unmarshal(data, (*failureDomain)(f))
+}
+
 // ScalewayClusterSpec defines the desired state of ScalewayCluster
 type ScalewayClusterSpec struct {
 	// ControlPlaneEndpoint represents the endpoint used to communicate with the control plane.
 	// +optional
 	ControlPlaneEndpoint clusterv1beta1.APIEndpoint `json:"controlPlaneEndpoint"`
 
-	// FailureDomains is a list of failure domains where the control-plane nodes
-	// and resources (loadbalancer, public gateway, etc.) will be created.
+	// FailureDomains is a list of failure domains where the nodes and
+	// resources (loadbalancer, public gateway, etc.) will be created.
 	// +optional
-	FailureDomains []string `json:"failureDomains,omitempty"`
+	FailureDomains []FailureDomain `json:"failureDomains,omitempty"`
 
 	// Region represents the region where the cluster will be hosted.
 	Region string `json:"region"`
@@ -35,12 +73,35 @@ type ScalewayClusterSpec struct {
 	// +optional
 	ControlPlaneLoadBalancer *LoadBalancerSpec `json:"controlPlaneLoadBalancer,omitempty"`
 
+	// ControlPlaneDNS allows creating DNS records that point to the loadbalancer
+	// in Scaleway Domains and DNS. When set, the control-plane endpoint is the
+	// FQDN of the records instead of the IP of the loadbalancer.
+	// +optional
+	ControlPlaneDNS *ControlPlaneDNSSpec `json:"controlPlaneDNS,omitempty"`
+
 	// Name of the secret that contains the Scaleway client parameters.
 	// The following keys must be set: accessKey, secretKey, projectID.
 	// The following key is optional: apiURL.
 	ScalewaySecretName string `json:"scalewaySecretName"`
 }
 
+// ControlPlaneDNSSpec defines the DNS records of the control-plane endpoint.
+type ControlPlaneDNSSpec struct {
+	// Zone is an existing DNS zone of Scaleway Domains and DNS (e.g. example.com).
+	// +kubebuilder:validation:MinLength=1
+	Zone string `json:"zone"`
+
+	// Name of the records, relative to the zone. The A and AAAA records of this
+	// name are managed by the provider: existing records are replaced.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// TTL of the records in seconds. Defaults to 300.
+	// +kubebuilder:validation:Minimum=60
+	// +optional
+	TTL *uint32 `json:"ttl,omitempty"`
+}
+
 // NetworkSpec defines network specific settings.
 type NetworkSpec struct {
 	// PrivateNetwork allows attaching machines of the cluster to a Private
@@ -66,6 +127,13 @@ type SecurityGroup struct {
 	// Name of the security group. Must be unique in a list of security groups.
 	Name string `json:"name"`
 
+	// IDs of existing security groups to use, indexed by zone. When set, the
+	// security group is only available in these zones and its policies and
+	// rules are not managed: inbound and outbound must not be set. Existing
+	// security groups are never deleted.
+	// +optional
+	IDs map[string]string `json:"ids,omitempty"`
+
 	// Inbound policy. If not set, all inbound traffic is allowed.
 	// +optional
 	Inbound *SecurityGroupPolicy `json:"inbound,omitempty"`
@@ -246,6 +314,14 @@ type PrivateNetworkSpec struct {
 	// Private Networks.
 	// +optional
 	Subnet *string `json:"subnet,omitempty"`
+
+	// ControlPlaneIPs is a pool of static IPs for the control-plane machines.
+	// Each control-plane machine reserves a free IP of the pool through IPAM
+	// before its private NIC is created. The IPs must be in the subnet of the
+	// Private Network. The pool should have at least as many IPs as there are
+	// control-plane machines, including the machines created during rollouts.
+	// +optional
+	ControlPlaneIPs []string `json:"controlPlaneIPs,omitempty"`
 }
 
 // PublicGatewaySpec defines Public Gateway settings for the cluster.
@@ -270,19 +346,137 @@ type PublicGatewaySpec struct {
 	IP *string `json:"ip,omitempty"`
 
 	// Zone where to create the Public Gateway. Must be in the same region as the
-	// cluster. Defaults to the first zone of the region.
+	// cluster. Defaults to the first failure domain that supports Public
+	// Gateways.
 	// +optional
 	Zone *string `json:"zone,omitempty"`
+
+	// MultiZone creates an additional Public Gateway in every other failure
+	// domain that supports Public Gateways, attached to the same Private
+	// Network. The default route of the Private Network is advertised by a
+	// single Public Gateway: the gateway of the main zone when it is healthy,
+	// otherwise the gateway of another zone. Not compatible with id and ip.
+	// +optional
+	MultiZone *bool `json:"multiZone,omitempty"`
+
+	// Bastion configures the SSH bastion of the Public Gateways, which allows
+	// reaching the machines that are only attached to the Private Network.
+	// Allowed source ranges are not supported yet by the Public Gateway API
+	// used by the provider: the bastion accepts connections from any IP, so
+	// SSH access must be secured on the machines. This field is mutable.
+	// +optional
+	Bastion *BastionSpec `json:"bastion,omitempty"`
+
+	// PATRules forward public ports of the Public Gateways to private IPs of
+	// the Private Network. When set, they replace all the PAT rules of the
+	// Public Gateways. This field is mutable.
+	// +optional
+	PATRules []PATRule `json:"patRules,omitempty"`
+}
+
+// PATRuleProtocol is the protocol of a PAT rule.
+// +kubebuilder:validation:Enum=tcp;udp;both
+type PATRuleProtocol string
+
+const (
+	PATRuleProtocolTCP  PATRuleProtocol = "tcp"
+	PATRuleProtocolUDP  PATRuleProtocol = "udp"
+	PATRuleProtocolBoth PATRuleProtocol = "both"
+)
+
+// PATRule forwards a public port of the Public Gateway to a private target.
+type PATRule struct {
+	// Public port to listen on. It must be unique.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	PublicPort uint32 `json:"publicPort"`
+
+	// Private port to forward to. Defaults to the public port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	PrivatePort *uint32 `json:"privatePort,omitempty"`
+
+	// Protocol of the rule.
+	// +kubebuilder:default=tcp
+	// +optional
+	Protocol *PATRuleProtocol `json:"protocol,omitempty"`
+
+	// Target of the rule.
+	Target PATRuleTarget `json:"target"`
+}
+
+// PATRuleTarget is the private target of a PAT rule. Exactly one field must be
+// set.
+type PATRuleTarget struct {
+	// Private IP to forward to.
+	// +kubebuilder:validation:Format=ipv4
+	// +optional
+	PrivateIP *string `json:"privateIP,omitempty"`
+
+	// Name of a ScalewayMachine of the cluster, in the namespace of the
+	// ScalewayCluster. The rule forwards to its private IP.
+	// +optional
+	ScalewayMachine *string `json:"scalewayMachine,omitempty"`
+}
+
+// BastionSpec defines the SSH bastion settings of the Public Gateways.
+type BastionSpec struct {
+	// Set to true to enable the SSH bastion.
+	Enabled bool `json:"enabled"`
+
+	// Port of the SSH bastion.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:default=61000
+	// +optional
+	Port *uint32 `json:"port,omitempty"`
 }
 
 // LoadBalancerSpec defines control-plane loadbalancer settings for the cluster.
 type LoadBalancerSpec struct {
+	// Disabled prevents the provider from creating a loadbalancer, for example
+	// when the API server is fronted by an external VIP (e.g. kube-vip). The
+	// controlPlaneEndpoint of the ScalewayCluster must then be set by the user.
+	// No other loadbalancer field can be set.
+	// +optional
+	Disabled *bool `json:"disabled,omitempty"`
+
+	// ID of an existing loadbalancer to use for the control-plane. The
+	// loadbalancer is never deleted. You should also specify the zone field
+	// if the loadbalancer is not in the default zone.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// ID of an existing frontend of the loadbalancer to use for the
+	// control-plane. The frontend is never deleted and its ACLs are not
+	// managed: allowedRanges do not apply to it. It requires the id and
+	// backendID fields to be set, the frontend must forward traffic to this
+	// backend.
+	// +optional
+	FrontendID *string `json:"frontendID,omitempty"`
+
+	// ID of an existing backend of the loadbalancer to use for the
+	// control-plane. The backend is never deleted, but control-plane nodes are
+	// added to and removed from it. It requires the id field to be set.
+	// +optional
+	BackendID *string `json:"backendID,omitempty"`
+
 	// Zone where to create the loadbalancer. Must be in the same region as the
 	// cluster. Defaults to the first zone of the region.
 	// +optional
 	Zone *string `json:"zone,omitempty"`
 
-	// Load Balancer commercial offer type.
+	// ExtraZones is a list of additional zones where a loadbalancer is created
+	// with the same configuration. Control-plane nodes are added to all the
+	// loadbalancers, which are exposed through the round-robin DNS records of
+	// controlPlaneDNS: only the loadbalancers that are ready are added to the
+	// records. Requires controlPlaneDNS to be set.
+	// +optional
+	ExtraZones []string `json:"extraZones,omitempty"`
+
+	// Load Balancer commercial offer type. When changed, the loadbalancer is
+	// migrated to the new type (this causes a short downtime).
 	// +kubebuilder:default="LB-S"
 	// +optional
 	Type *string `json:"type,omitempty"`
@@ -292,27 +486,170 @@ type LoadBalancerSpec struct {
 	// +optional
 	IP *string `json:"ip,omitempty"`
 
+	// IPv6 assigns a flexible IPv6 to the loadbalancer, in addition to its
+	// IPv4. Cannot be used with a private loadbalancer.
+	// +optional
+	IPv6 *bool `json:"ipv6,omitempty"`
+
+	// EndpointIPFamily is the IP family of the loadbalancer IP that is used as
+	// control-plane endpoint. IPv6 requires the loadbalancer to have an IPv6.
+	// Defaults to IPv4.
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +optional
+	EndpointIPFamily *string `json:"endpointIPFamily,omitempty"`
+
+	// Private makes the loadbalancer only reachable from the Private Network
+	// of the cluster: it is created without any public IP and the control-plane
+	// endpoint is set to its private IP. Requires the Private Network to be
+	// enabled.
+	// +optional
+	Private *bool `json:"private,omitempty"`
+
+	// PrivateIP is a static IP to use for the loadbalancer in the Private
+	// Network of the cluster. If unset, an IP is booked by IPAM.
+	// +kubebuilder:validation:Format=ipv4
+	// +optional
+	PrivateIP *string `json:"privateIP,omitempty"`
+
+	// Port of the control-plane frontend. Defaults to the APIServerPort of the
+	// Cluster network if set, or 6443.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// BackendPort is the port of the kube-apiserver on control-plane nodes.
+	// Defaults to 6443.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	BackendPort *int32 `json:"backendPort,omitempty"`
+
+	// HealthCheck of the control-plane backend. Defaults to a TCP health check.
+	// +optional
+	HealthCheck *LoadBalancerHealthCheck `json:"healthCheck,omitempty"`
+
+	// ExtraListeners is a list of additional listeners of the loadbalancer for
+	// services that run on control-plane nodes (e.g. RKE2 supervisor, Talos
+	// API, konnectivity). Control-plane nodes are added to the backends of all
+	// listeners. ACLs also apply to these listeners.
+	// +optional
+	ExtraListeners []LoadBalancerListener `json:"extraListeners,omitempty"`
+
 	// AllowedRanges allows to set a list of allowed IP ranges that can access
 	// the cluster through the load balancer. When unset, all IP ranges are allowed.
 	// To allow the cluster to work properly, public IPs of nodes and Public
 	// Gateways will automatically be allowed. However, if this field is set,
-	// you MUST manually allow IPs of the nodes of your management cluster.
+	// you MUST allow IPs of the nodes of your management cluster, either
+	// manually or with the --management-cluster-egress-* flags of the manager.
+	// Both IPv4 and IPv6 ranges are supported.
 	// +optional
 	AllowedRanges []string `json:"allowedRanges,omitempty"`
 }
 
+const (
+	// IPFamilyIPv4 selects IPv4 addresses.
+	IPFamilyIPv4 = "IPv4"
+	// IPFamilyIPv6 selects IPv6 addresses.
+	IPFamilyIPv6 = "IPv6"
+)
+
+// LoadBalancerListener defines an additional frontend and backend pair of the
+// loadbalancer.
+type LoadBalancerListener struct {
+	// Name of the listener. The frontend and backend are named after it. Must
+	// be unique in the list of listeners and must not be "control-plane".
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Port of the frontend.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+
+	// BackendPort is the port of the service on control-plane nodes. Defaults
+	// to the frontend port.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	BackendPort *int32 `json:"backendPort,omitempty"`
+
+	// Protocol used to forward traffic to control-plane nodes. Defaults to TCP.
+	// +kubebuilder:validation:Enum=TCP;HTTP
+	// +optional
+	Protocol *LoadBalancerProtocol `json:"protocol,omitempty"`
+
+	// HealthCheck of the backend. Defaults to a TCP health check.
+	// +optional
+	HealthCheck *LoadBalancerHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// LoadBalancerProtocol is the protocol used by a loadbalancer backend.
+type LoadBalancerProtocol string
+
+const (
+	// LoadBalancerProtocolTCP forwards TCP traffic.
+	LoadBalancerProtocolTCP LoadBalancerProtocol = "TCP"
+	// LoadBalancerProtocolHTTP forwards HTTP traffic.
+	LoadBalancerProtocolHTTP LoadBalancerProtocol = "HTTP"
+)
+
+// LoadBalancerHealthCheckProtocol is the protocol of a loadbalancer health check.
+type LoadBalancerHealthCheckProtocol string
+
+const (
+	// LoadBalancerHealthCheckProtocolTCP checks that a TCP connection can be
+	// established with the server.
+	LoadBalancerHealthCheckProtocolTCP LoadBalancerHealthCheckProtocol = "TCP"
+	// LoadBalancerHealthCheckProtocolHTTPS checks that an HTTPS GET request to
+	// the server returns a 200 status code.
+	LoadBalancerHealthCheckProtocolHTTPS LoadBalancerHealthCheckProtocol = "HTTPS"
+)
+
+// LoadBalancerHealthCheck defines the health check of a loadbalancer backend.
+type LoadBalancerHealthCheck struct {
+	// Protocol of the health check. Defaults to TCP.
+	// +kubebuilder:validation:Enum=TCP;HTTPS
+	// +optional
+	Protocol *LoadBalancerHealthCheckProtocol `json:"protocol,omitempty"`
+
+	// Path requested by HTTPS health checks. Defaults to /readyz.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Interval between two health checks.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// Timeout of a health check.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// MaxRetries is the number of consecutive failed health checks before a
+	// server is considered unhealthy. Defaults to 5.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+}
+
 // ScalewayClusterStatus defines the observed state of ScalewayCluster
 type ScalewayClusterStatus struct {
 	// Ready is true when all cloud resources are created and ready.
 	// +kubebuilder:default=false
 	Ready bool `json:"ready"`
 
-	// List of failure domains for this cluster.
+	// List of failure domains for this cluster. The attributes of each failure
+	// domain describe the capabilities of the zone: available instance types
+	// (instanceTypes), SBS volumes (sbs) and GPU instances (gpu).
 	FailureDomains clusterv1beta1.FailureDomains `json:"failureDomains,omitempty"`
 
 	// Network status.
 	// +optional
 	Network *NetworkStatus `json:"network,omitempty"`
+
+	// LoadBalancer status.
+	// +optional
+	LoadBalancer *LoadBalancerStatus `json:"loadBalancer,omitempty"`
 }
 
 // NetworkStatus contains network status related data.
@@ -321,9 +658,197 @@ type NetworkStatus struct {
 	// +optional
 	PrivateNetworkID *string `json:"privateNetworkID,omitempty"`
 
+	// IPv4 subnets of the Private Network. They are used to validate the
+	// static private IPs of the machines.
+	// +optional
+	PrivateNetworkSubnets []string `json:"privateNetworkSubnets,omitempty"`
+
+	// Status of the Public Gateway of the main zone.
+	PublicGatewayStatus `json:",inline"`
+
+	// Status of the Public Gateways of the other zones when multiZone is
+	// enabled.
+	// +optional
+	ExtraPublicGateways []PublicGatewayStatus `json:"extraPublicGateways,omitempty"`
+
+	// Migration tracks the migration of the machines to the Private Network
+	// when it is enabled on a running cluster.
+	// +optional
+	Migration *PrivateNetworkMigrationStatus `json:"migration,omitempty"`
+
+	// Security groups of the cluster, indexed by their name in the spec.
+	// +optional
+	SecurityGroups map[string]SecurityGroupStatus `json:"securityGroups,omitempty"`
+}
+
+// PrivateNetworkMigrationStatus contains the progress of the migration of a
+// running cluster to the Private Network.
+type PrivateNetworkMigrationStatus struct {
+	// Completed is true when all the machines are attached to the Private
+	// Network and all the loadbalancer backend servers use private IPs.
+	Completed bool `json:"completed"`
+
+	// Names of the ScalewayMachines that are not attached to the Private
+	// Network yet.
+	// +optional
+	PendingMachines []string `json:"pendingMachines,omitempty"`
+
+	// Names of the control-plane ScalewayMachines that are still reached by
+	// the loadbalancers through their public IP.
+	// +optional
+	PendingBackendServers []string `json:"pendingBackendServers,omitempty"`
+}
+
+// PublicGatewayStatus contains the status of a Public Gateway.
+type PublicGatewayStatus struct {
 	// ID of the Public Gateway if available.
 	// +optional
 	PublicGatewayID *string `json:"publicGatewayID,omitempty"`
+
+	// ID of the Public Gateway IP if available.
+	// +optional
+	PublicGatewayIPID *string `json:"publicGatewayIPID,omitempty"`
+
+	// Address of the Public Gateway IP if available.
+	// +optional
+	PublicGatewayIP *string `json:"publicGatewayIP,omitempty"`
+
+	// Port of the SSH bastion if it is enabled.
+	// +optional
+	BastionPort *uint32 `json:"bastionPort,omitempty"`
+
+	// Zone of the Public Gateway. It is recorded when the Public Gateway is
+	// created so that it never changes.
+	// +optional
+	PublicGatewayZone *string `json:"publicGatewayZone,omitempty"`
+
+	// ID of the Gateway Network (the attachment of the Public Gateway to the
+	// Private Network) if available.
+	// +optional
+	GatewayNetworkID *string `json:"gatewayNetworkID,omitempty"`
+
+	// DefaultRoute is true if the Public Gateway advertises the default route
+	// of the Private Network.
+	// +optional
+	DefaultRoute bool `json:"defaultRoute,omitempty"`
+}
+
+// SecurityGroupStatus contains the IDs of a security group.
+type SecurityGroupStatus struct {
+	// IDs of the security group, indexed by zone.
+	// +optional
+	IDs map[string]string `json:"ids,omitempty"`
+
+	// Imported is true if the security group was not created by the provider.
+	// +optional
+	Imported bool `json:"imported,omitempty"`
+}
+
+// LoadBalancerStatus contains the IDs of the control-plane loadbalancer resources.
+// The status of the loadbalancer of the main zone is inlined.
+type LoadBalancerStatus struct {
+	LoadBalancerZoneStatus `json:",inline"`
+
+	// ExtraZones contains the status of the loadbalancers of the extra zones.
+	// +optional
+	ExtraZones []LoadBalancerZoneStatus `json:"extraZones,omitempty"`
+}
+
+// LoadBalancerZoneStatus contains the IDs of the resources of the loadbalancer
+// of a zone.
+type LoadBalancerZoneStatus struct {
+	// Zone of the loadbalancer. The zone of the main loadbalancer is recorded
+	// when it is chosen so that it never changes.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+
+	// Ready is true when the loadbalancer is ready and was successfully
+	// configured.
+	// +optional
+	Ready bool `json:"ready,omitempty"`
+
+	// ID of the loadbalancer if available.
+	// +optional
+	LoadBalancerID *string `json:"loadBalancerID,omitempty"`
+
+	// IDs of the IPs attached to the loadbalancer.
+	// +optional
+	IPIDs []string `json:"ipIDs,omitempty"`
+
+	// IPs are the addresses of the IPs attached to the loadbalancer.
+	// +optional
+	IPs []string `json:"ips,omitempty"`
+
+	// Type is the current commercial offer type of the loadbalancer. It differs
+	// from the type in the spec while the loadbalancer is migrated.
+	// +optional
+	Type *string `json:"type,omitempty"`
+
+	// State of the loadbalancer (e.g. ready, migrating).
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// PrivateIP is the IP of the loadbalancer in the Private Network of the
+	// cluster if available. Nodes can use it to reach the control-plane
+	// without going through the public IP of the loadbalancer.
+	// +optional
+	PrivateIP *string `json:"privateIP,omitempty"`
+
+	// Names of the control-plane ScalewayMachines that the loadbalancer still
+	// reaches through their public IP while they are migrated to the Private
+	// Network.
+	// +optional
+	PendingBackendServers []string `json:"pendingBackendServers,omitempty"`
+
+	// ID of the control-plane frontend if available.
+	// +optional
+	FrontendID *string `json:"frontendID,omitempty"`
+
+	// ID of the control-plane backend if available.
+	// +optional
+	BackendID *string `json:"backendID,omitempty"`
+
+	// IDs of the ACLs of the frontends, indexed by ACL name. The names of
+	// the ACLs of extra listeners are prefixed by the listener name.
+	// +optional
+	ACLIDs map[string]string `json:"aclIDs,omitempty"`
+
+	// Listeners contains the IDs of the extra listeners, indexed by name.
+	// +optional
+	Listeners map[string]LoadBalancerListenerStatus `json:"listeners,omitempty"`
+
+	// ImportedLoadBalancer is true if the loadbalancer was not created by the
+	// provider.
+	// +optional
+	ImportedLoadBalancer bool `json:"importedLoadBalancer,omitempty"`
+
+	// ImportedFrontend is true if the control-plane frontend was not created
+	// by the provider.
+	// +optional
+	ImportedFrontend bool `json:"importedFrontend,omitempty"`
+
+	// ImportedBackend is true if the control-plane backend was not created by
+	// the provider.
+	// +optional
+	ImportedBackend bool `json:"importedBackend,omitempty"`
+
+	// BackendServerIPs contains the IPs of the servers that were added by the
+	// provider to the control-plane backend when it was not created by the
+	// provider. The other servers of this backend are never removed.
+	// +optional
+	BackendServerIPs []string `json:"backendServerIPs,omitempty"`
+}
+
+// LoadBalancerListenerStatus contains the IDs of the resources of an extra
+// listener.
+type LoadBalancerListenerStatus struct {
+	// ID of the frontend if available.
+	// +optional
+	FrontendID string `json:"frontendID,omitempty"`
+
+	// ID of the backend if available.
+	// +optional
+	BackendID string `json:"backendID,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -0,0 +1,55 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestFailureDomainUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    []FailureDomain
+		wantErr bool
+	}{
+		{
+			name: "plain zones",
+			data: `["fr-par-1", "fr-par-2"]`,
+			want: []FailureDomain{{Zone: "fr-par-1"}, {Zone: "fr-par-2"}},
+		},
+		{
+			name: "objects",
+			data: `[{"zone": "fr-par-1"}, {"zone": "fr-par-2", "controlPlane": false, "attributes": {"gpu": "true"}}]`,
+			want: []FailureDomain{
+				{Zone: "fr-par-1"},
+				{Zone: "fr-par-2", ControlPlane: scw.BoolPtr(false), Attributes: map[string]string{"gpu": "true"}},
+			},
+		},
+		{
+			name: "plain zones and objects",
+			data: `["fr-par-1", {"zone": "fr-par-2"}]`,
+			want: []FailureDomain{{Zone: "fr-par-1"}, {Zone: "fr-par-2"}},
+		},
+		{
+			name:    "invalid failure domain",
+			data:    `[1]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []FailureDomain
+			if err := json.Unmarshal([]byte(tt.data), &got); (err != nil) != tt.wantErr {
+				t.Fatalf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("json.Unmarshal() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,10 +2,14 @@ package v1beta1
 
 import (
 	"net"
+	"net/netip"
 	"reflect"
 
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -57,6 +61,109 @@ func (r *ScalewayCluster) validate() error {
 	return apierrors.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "ScalewayCluster"}, r.Name, allErrs)
 }
 
+// privateNetworkSubnets returns the IPv4 subnets of the Private Network of the
+// cluster: the subnet of the spec if it is set, otherwise the subnets found by
+// the controller. It returns nil if the subnets are not known yet.
+func (r *ScalewayCluster) privateNetworkSubnets() []netip.Prefix {
+	var subnets []string
+
+	switch {
+	case r.Spec.Network == nil || r.Spec.Network.PrivateNetwork == nil:
+		return nil
+	case r.Spec.Network.PrivateNetwork.Subnet != nil:
+		subnets = []string{*r.Spec.Network.PrivateNetwork.Subnet}
+	case r.Status.Network != nil:
+		subnets = r.Status.Network.PrivateNetworkSubnets
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(subnets))
+	for _, subnet := range subnets {
+		if prefix, err := netip.ParsePrefix(subnet); err == nil {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// validatePrivateIP verifies that ip is an IPv4 address in one of the subnets.
+// The subnets are not checked if they are not known yet.
+func validatePrivateIP(ip string, subnets []netip.Prefix, path *field.Path) *field.Error {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil || !addr.Is4() {
+		return field.Invalid(path, ip, "must be an IPv4 address")
+	}
+
+	if len(subnets) == 0 || slices.ContainsFunc(subnets, func(subnet netip.Prefix) bool {
+		return subnet.Contains(addr)
+	}) {
+		return nil
+	}
+
+	return field.Invalid(path, ip, "must be in the subnet of the Private Network")
+}
+
+// validateControlPlaneIPs verifies that the control-plane IPs are unique IPv4
+// addresses in the subnets of the Private Network.
+func validateControlPlaneIPs(ips []string, subnets []netip.Prefix) *field.Error {
+	unique := make(map[string]struct{}, len(ips))
+
+	for i, ip := range ips {
+		path := field.NewPath("spec", "network", "privateNetwork", "controlPlaneIPs").Index(i)
+
+		if err := validatePrivateIP(ip, subnets, path); err != nil {
+			return err
+		}
+
+		if _, ok := unique[ip]; ok {
+			return field.Duplicate(path, ip)
+		}
+
+		unique[ip] = struct{}{}
+	}
+
+	return nil
+}
+
+// validatePATRules verifies that public ports are unique, that each rule has
+// exactly one target and that private IPs are in the subnets of the Private
+// Network.
+func validatePATRules(rules []PATRule, subnets []netip.Prefix) *field.Error {
+	publicPorts := make(map[uint32]struct{}, len(rules))
+
+	for i, rule := range rules {
+		path := field.NewPath("spec", "network", "publicGateway", "patRules").Index(i)
+
+		if _, ok := publicPorts[rule.PublicPort]; ok {
+			return field.Duplicate(path.Child("publicPort"), rule.PublicPort)
+		}
+
+		publicPorts[rule.PublicPort] = struct{}{}
+
+		if (rule.Target.PrivateIP == nil) == (rule.Target.ScalewayMachine == nil) {
+			return field.Invalid(path.Child("target"), rule.Target, "exactly one of privateIP and scalewayMachine must be set")
+		}
+
+		if rule.Target.PrivateIP != nil {
+			if err := validatePrivateIP(*rule.Target.PrivateIP, subnets, path.Child("target", "privateIP")); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadBalancerZones returns the zones where loadbalancers are available.
+func loadBalancerZones() []scw.Zone {
+	return (&lb.ZonedAPI{}).Zones()
+}
+
+// publicGatewayZones returns the zones where Public Gateways are available.
+func publicGatewayZones() []scw.Zone {
+	return (&vpcgw.API{}).Zones()
+}
+
 func (r *ScalewayCluster) validateRegion() (scw.Region, *field.Error) {
 	region, err := scw.ParseRegion(r.Spec.Region)
 	if err != nil {
@@ -74,36 +181,86 @@ func (r *ScalewayCluster) validateFailureDomains(region scw.Region) *field.Error
 	// If set, FailureDomains must:
 	// - have no duplicates
 	// - be in the same region as the cluster region
+	// - allow control-plane machines in at least one zone
 	dupeMap := make(map[scw.Zone]struct{})
+	controlPlane := false
 
 	for i, fd := range r.Spec.FailureDomains {
-		f := field.NewPath("spec", "failureDomains").Index(i)
-		zone, err := scw.ParseZone(fd)
+		f := field.NewPath("spec", "failureDomains").Index(i).Child("zone")
+		zone, err := scw.ParseZone(fd.Zone)
 		if err != nil {
-			return field.Invalid(f, fd, err.Error())
+			return field.Invalid(f, fd.Zone, err.Error())
 		}
 
 		zoneRegion, err := zone.Region()
 		if err != nil {
-			return field.Invalid(f, fd, err.Error())
+			return field.Invalid(f, fd.Zone, err.Error())
 		}
 
 		if region != zoneRegion {
-			return field.Invalid(f, fd, "failureDomain must be in the cluster region")
+			return field.Invalid(f, fd.Zone, "failureDomain must be in the cluster region")
 		}
 
 		if _, ok := dupeMap[zone]; ok {
-			return field.Duplicate(f, fd)
+			return field.Duplicate(f, fd.Zone)
 		}
 
 		dupeMap[zone] = struct{}{}
+
+		if fd.ControlPlane == nil || *fd.ControlPlane {
+			controlPlane = true
+		}
+	}
+
+	if !controlPlane {
+		return field.Invalid(
+			field.NewPath("spec", "failureDomains"),
+			r.Spec.FailureDomains,
+			"at least one failureDomain must allow control-plane machines",
+		)
 	}
 
 	return nil
 }
 
 func (r *ScalewayCluster) validateLoadBalancerSpec(region scw.Region) *field.Error {
-	if r.Spec.ControlPlaneLoadBalancer == nil || r.Spec.ControlPlaneLoadBalancer.Zone == nil {
+	if r.Spec.ControlPlaneLoadBalancer == nil {
+		return nil
+	}
+
+	if r.Spec.ControlPlaneLoadBalancer.Disabled != nil && *r.Spec.ControlPlaneLoadBalancer.Disabled {
+		return r.validateDisabledLoadBalancer()
+	}
+
+	if err := r.validateExistingLoadBalancer(); err != nil {
+		return err
+	}
+
+	if err := r.validatePrivateLoadBalancer(); err != nil {
+		return err
+	}
+
+	if err := validateHealthCheck(r.Spec.ControlPlaneLoadBalancer.HealthCheck, field.NewPath("spec", "controlPlaneLoadBalancer", "healthCheck")); err != nil {
+		return err
+	}
+
+	if err := r.validateExtraListeners(); err != nil {
+		return err
+	}
+
+	if err := r.validateIPv6LoadBalancer(); err != nil {
+		return err
+	}
+
+	if err := r.validateAllowedRanges(); err != nil {
+		return err
+	}
+
+	if err := r.validateExtraZones(region); err != nil {
+		return err
+	}
+
+	if r.Spec.ControlPlaneLoadBalancer.Zone == nil {
 		return nil
 	}
 
@@ -125,6 +282,257 @@ func (r *ScalewayCluster) validateLoadBalancerSpec(region scw.Region) *field.Err
 		return field.Invalid(f, *r.Spec.ControlPlaneLoadBalancer.Zone, "loadbalancer zone must be in the cluster region")
 	}
 
+	if !slices.Contains(loadBalancerZones(), zone) {
+		return field.Invalid(f, *r.Spec.ControlPlaneLoadBalancer.Zone, "loadbalancers are not available in this zone")
+	}
+
+	return nil
+}
+
+// validateDisabledLoadBalancer validates that the control-plane endpoint is set
+// by the user and that no other loadbalancer field is set when the loadbalancer
+// is disabled.
+func (r *ScalewayCluster) validateDisabledLoadBalancer() *field.Error {
+	path := field.NewPath("spec", "controlPlaneLoadBalancer")
+
+	// The type is defaulted by the API server, it is ignored.
+	spec := r.Spec.ControlPlaneLoadBalancer.DeepCopy()
+	spec.Type = nil
+
+	if !reflect.DeepEqual(*spec, LoadBalancerSpec{Disabled: spec.Disabled}) {
+		return field.Invalid(path, r.Spec.ControlPlaneLoadBalancer, "no other field can be set when the loadbalancer is disabled")
+	}
+
+	if r.Spec.ControlPlaneDNS != nil {
+		return field.Invalid(field.NewPath("spec", "controlPlaneDNS"), r.Spec.ControlPlaneDNS, "controlPlaneDNS cannot be set when the loadbalancer is disabled")
+	}
+
+	if !r.Spec.ControlPlaneEndpoint.IsValid() {
+		return field.Required(field.NewPath("spec", "controlPlaneEndpoint"), "controlPlaneEndpoint must be set when the loadbalancer is disabled")
+	}
+
+	return nil
+}
+
+// validateExistingLoadBalancer validates the fields that allow using an
+// existing loadbalancer, frontend and backend.
+func (r *ScalewayCluster) validateExistingLoadBalancer() *field.Error {
+	spec := r.Spec.ControlPlaneLoadBalancer
+	path := field.NewPath("spec", "controlPlaneLoadBalancer")
+
+	if spec.ID == nil {
+		if spec.FrontendID != nil {
+			return field.Invalid(path.Child("frontendID"), *spec.FrontendID, "id must be set to use an existing frontend")
+		}
+
+		if spec.BackendID != nil {
+			return field.Invalid(path.Child("backendID"), *spec.BackendID, "id must be set to use an existing backend")
+		}
+
+		return nil
+	}
+
+	if spec.IP != nil {
+		return field.Invalid(path.Child("ip"), *spec.IP, "ip should not be specified because id is set")
+	}
+
+	if spec.FrontendID != nil && spec.BackendID == nil {
+		return field.Required(path.Child("backendID"), "backendID must be set to use an existing frontend")
+	}
+
+	return nil
+}
+
+// validatePrivateLoadBalancer validates the fields related to the Private
+// Network of the loadbalancer.
+func (r *ScalewayCluster) validatePrivateLoadBalancer() *field.Error {
+	spec := r.Spec.ControlPlaneLoadBalancer
+	path := field.NewPath("spec", "controlPlaneLoadBalancer")
+
+	hasPrivateNetwork := r.Spec.Network != nil &&
+		r.Spec.Network.PrivateNetwork != nil &&
+		r.Spec.Network.PrivateNetwork.Enabled
+
+	if spec.Private != nil && *spec.Private {
+		if !hasPrivateNetwork {
+			return field.Invalid(path.Child("private"), *spec.Private, "private network must be enabled")
+		}
+
+		if spec.IP != nil {
+			return field.Invalid(path.Child("ip"), *spec.IP, "ip should not be specified because private is true")
+		}
+	}
+
+	if spec.PrivateIP != nil {
+		if !hasPrivateNetwork {
+			return field.Invalid(path.Child("privateIP"), *spec.PrivateIP, "private network must be enabled")
+		}
+
+		if net.ParseIP(*spec.PrivateIP) == nil {
+			return field.Invalid(path.Child("privateIP"), *spec.PrivateIP, "invalid IP address")
+		}
+	}
+
+	return nil
+}
+
+// validateIPv6LoadBalancer validates the fields related to the IPv6 of the
+// loadbalancer.
+func (r *ScalewayCluster) validateIPv6LoadBalancer() *field.Error {
+	spec := r.Spec.ControlPlaneLoadBalancer
+	path := field.NewPath("spec", "controlPlaneLoadBalancer")
+
+	hasIPv6 := spec.IPv6 != nil && *spec.IPv6
+
+	if hasIPv6 && spec.Private != nil && *spec.Private {
+		return field.Invalid(path.Child("ipv6"), *spec.IPv6, "ipv6 cannot be enabled because private is true")
+	}
+
+	// An existing loadbalancer may already have an IPv6.
+	if spec.EndpointIPFamily != nil && *spec.EndpointIPFamily == IPFamilyIPv6 && !hasIPv6 && spec.ID == nil {
+		return field.Invalid(path.Child("endpointIPFamily"), *spec.EndpointIPFamily, "ipv6 must be enabled")
+	}
+
+	return nil
+}
+
+// validateExtraZones validates the extra zones of the loadbalancer. They must be
+// valid zones of the cluster region without duplicates. The loadbalancers are
+// only exposed through DNS and only support the options that can be shared
+// by all of them.
+func (r *ScalewayCluster) validateExtraZones(region scw.Region) *field.Error {
+	spec := r.Spec.ControlPlaneLoadBalancer
+	path := field.NewPath("spec", "controlPlaneLoadBalancer")
+
+	if len(spec.ExtraZones) == 0 {
+		return nil
+	}
+
+	if r.Spec.ControlPlaneDNS == nil {
+		return field.Required(field.NewPath("spec", "controlPlaneDNS"), "controlPlaneDNS must be set to use extra zones")
+	}
+
+	switch {
+	case spec.ID != nil:
+		return field.Invalid(path.Child("id"), *spec.ID, "id cannot be set with extra zones")
+	case spec.IP != nil:
+		return field.Invalid(path.Child("ip"), *spec.IP, "ip cannot be set with extra zones")
+	case spec.Private != nil && *spec.Private:
+		return field.Invalid(path.Child("private"), *spec.Private, "private cannot be set with extra zones")
+	case spec.PrivateIP != nil:
+		return field.Invalid(path.Child("privateIP"), *spec.PrivateIP, "privateIP cannot be set with extra zones")
+	}
+
+	dupeMap := make(map[scw.Zone]struct{})
+	if spec.Zone != nil {
+		dupeMap[scw.Zone(*spec.Zone)] = struct{}{}
+	}
+
+	for i, z := range spec.ExtraZones {
+		f := path.Child("extraZones").Index(i)
+		zone, err := scw.ParseZone(z)
+		if err != nil {
+			return field.Invalid(f, z, err.Error())
+		}
+
+		zoneRegion, err := zone.Region()
+		if err != nil {
+			return field.Invalid(f, z, err.Error())
+		}
+
+		if zoneRegion != region {
+			return field.Invalid(f, z, "loadbalancer zone must be in the cluster region")
+		}
+
+		if !slices.Contains(loadBalancerZones(), zone) {
+			return field.Invalid(f, z, "loadbalancers are not available in this zone")
+		}
+
+		if _, ok := dupeMap[zone]; ok {
+			return field.Duplicate(f, z)
+		}
+
+		dupeMap[zone] = struct{}{}
+	}
+
+	return nil
+}
+
+// validateAllowedRanges validates that the allowed ranges are valid IPv4 or
+// IPv6 addresses or CIDRs.
+func (r *ScalewayCluster) validateAllowedRanges() *field.Error {
+	for i, allowedRange := range r.Spec.ControlPlaneLoadBalancer.AllowedRanges {
+		if _, err := netip.ParsePrefix(allowedRange); err == nil {
+			continue
+		}
+
+		if _, err := netip.ParseAddr(allowedRange); err != nil {
+			return field.Invalid(
+				field.NewPath("spec", "controlPlaneLoadBalancer", "allowedRanges").Index(i),
+				allowedRange,
+				"must be a valid IP address or CIDR",
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateExtraListeners validates the extra listeners of the loadbalancer:
+// names and frontend ports must be unique.
+func (r *ScalewayCluster) validateExtraListeners() *field.Error {
+	uniqueNames := make(map[string]struct{})
+	uniquePorts := make(map[int32]struct{})
+
+	if r.Spec.ControlPlaneLoadBalancer.Port != nil {
+		uniquePorts[*r.Spec.ControlPlaneLoadBalancer.Port] = struct{}{}
+	}
+
+	for i, listener := range r.Spec.ControlPlaneLoadBalancer.ExtraListeners {
+		path := field.NewPath("spec", "controlPlaneLoadBalancer", "extraListeners").Index(i)
+
+		if listener.Name == "control-plane" {
+			return field.Invalid(path.Child("name"), listener.Name, "name is reserved")
+		}
+
+		if _, ok := uniqueNames[listener.Name]; ok {
+			return field.Duplicate(path.Child("name"), listener.Name)
+		}
+
+		uniqueNames[listener.Name] = struct{}{}
+
+		if _, ok := uniquePorts[listener.Port]; ok {
+			return field.Duplicate(path.Child("port"), listener.Port)
+		}
+
+		uniquePorts[listener.Port] = struct{}{}
+
+		if err := validateHealthCheck(listener.HealthCheck, path.Child("healthCheck")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateHealthCheck validates a loadbalancer health check.
+func validateHealthCheck(hc *LoadBalancerHealthCheck, path *field.Path) *field.Error {
+	if hc == nil {
+		return nil
+	}
+
+	if hc.Path != nil && (hc.Protocol == nil || *hc.Protocol != LoadBalancerHealthCheckProtocolHTTPS) {
+		return field.Invalid(path.Child("path"), *hc.Path, "path can only be set for HTTPS health checks")
+	}
+
+	if hc.Interval != nil && hc.Interval.Duration <= 0 {
+		return field.Invalid(path.Child("interval"), hc.Interval.Duration.String(), "interval must be positive")
+	}
+
+	if hc.Timeout != nil && hc.Timeout.Duration <= 0 {
+		return field.Invalid(path.Child("timeout"), hc.Timeout.Duration.String(), "timeout must be positive")
+	}
+
 	return nil
 }
 
@@ -134,6 +542,12 @@ func (r *ScalewayCluster) validateNetworkSpec(region scw.Region) *field.Error {
 		return nil
 	}
 
+	if r.Spec.Network.PrivateNetwork != nil {
+		if err := validateControlPlaneIPs(r.Spec.Network.PrivateNetwork.ControlPlaneIPs, r.privateNetworkSubnets()); err != nil {
+			return err
+		}
+	}
+
 	if r.Spec.Network.PublicGateway != nil {
 		if r.Spec.Network.PublicGateway.Zone != nil {
 			zone, err := scw.ParseZone(*r.Spec.Network.PublicGateway.Zone)
@@ -161,6 +575,14 @@ func (r *ScalewayCluster) validateNetworkSpec(region scw.Region) *field.Error {
 					"public gateway must be in the cluster region",
 				)
 			}
+
+			if !slices.Contains(publicGatewayZones(), zone) {
+				return field.Invalid(
+					field.NewPath("spec", "network", "publicGateway", "zone"),
+					*r.Spec.Network.PublicGateway.Zone,
+					"public gateways are not available in this zone",
+				)
+			}
 		}
 
 		if r.Spec.Network.PublicGateway.ID != nil {
@@ -181,14 +603,35 @@ func (r *ScalewayCluster) validateNetworkSpec(region scw.Region) *field.Error {
 			}
 
 			if r.Spec.Network.PublicGateway.Zone == nil {
-				return field.Invalid(
+				return field.Required(
 					field.NewPath("spec", "network", "publicGateway", "zone"),
-					*r.Spec.Network.PublicGateway.Zone,
 					"zone is needed",
 				)
 			}
 		}
 
+		if err := validatePATRules(r.Spec.Network.PublicGateway.PATRules, r.privateNetworkSubnets()); err != nil {
+			return err
+		}
+
+		if r.Spec.Network.PublicGateway.MultiZone != nil && *r.Spec.Network.PublicGateway.MultiZone {
+			if r.Spec.Network.PublicGateway.ID != nil {
+				return field.Invalid(
+					field.NewPath("spec", "network", "publicGateway", "id"),
+					*r.Spec.Network.PublicGateway.ID,
+					"id should not be specified because multiZone is enabled",
+				)
+			}
+
+			if r.Spec.Network.PublicGateway.IP != nil {
+				return field.Invalid(
+					field.NewPath("spec", "network", "publicGateway", "ip"),
+					*r.Spec.Network.PublicGateway.IP,
+					"ip should not be specified because multiZone is enabled",
+				)
+			}
+		}
+
 		if r.Spec.Network.PrivateNetwork != nil {
 			// Subnet won't work with an existing Private Network.
 			if r.Spec.Network.PrivateNetwork.Subnet != nil &&
@@ -212,6 +655,14 @@ func (r *ScalewayCluster) validateNetworkSpec(region scw.Region) *field.Error {
 
 		uniqueNames[sg.Name] = struct{}{}
 
+		if len(sg.IDs) > 0 {
+			if err := r.validateExistingSecurityGroup(sg, path, region); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		if err := r.validateSecurityGroupPolicy(sg.Inbound, path.Child("inbound")); err != nil {
 			return err
 		}
@@ -224,6 +675,36 @@ func (r *ScalewayCluster) validateNetworkSpec(region scw.Region) *field.Error {
 	return nil
 }
 
+// validateExistingSecurityGroup validates a security group that references
+// existing security groups.
+func (r *ScalewayCluster) validateExistingSecurityGroup(sg SecurityGroup, path *field.Path, region scw.Region) *field.Error {
+	if sg.Inbound != nil {
+		return field.Invalid(path.Child("inbound"), sg.Inbound, "inbound should not be specified because ids is set")
+	}
+
+	if sg.Outbound != nil {
+		return field.Invalid(path.Child("outbound"), sg.Outbound, "outbound should not be specified because ids is set")
+	}
+
+	for z := range sg.IDs {
+		zone, err := scw.ParseZone(z)
+		if err != nil {
+			return field.Invalid(path.Child("ids").Key(z), z, err.Error())
+		}
+
+		zoneRegion, err := zone.Region()
+		if err != nil {
+			return field.Invalid(path.Child("ids").Key(z), z, err.Error())
+		}
+
+		if region != zoneRegion {
+			return field.Invalid(path.Child("ids").Key(z), z, "security group must be in the cluster region")
+		}
+	}
+
+	return nil
+}
+
 func (r *ScalewayCluster) validateSecurityGroupPolicy(sgp *SecurityGroupPolicy, path *field.Path) *field.Error {
 	if sgp == nil {
 		return nil
@@ -282,12 +763,34 @@ func (r *ScalewayCluster) enforceImmutability(old *ScalewayCluster) error {
 		old.Spec.Network = &NetworkSpec{}
 	}
 
-	if !reflect.DeepEqual(r.Spec.Network.PrivateNetwork, old.Spec.Network.PrivateNetwork) {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "privateNetwork"), r.Spec.Network.PrivateNetwork, "field is immutable"))
+	if !reflect.DeepEqual(r.Spec.ControlPlaneDNS, old.Spec.ControlPlaneDNS) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneDNS"), r.Spec.ControlPlaneDNS, "field is immutable"))
 	}
 
-	if !reflect.DeepEqual(r.Spec.Network.PublicGateway, old.Spec.Network.PublicGateway) {
-		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "publicGateway"), r.Spec.Network.PublicGateway, "field is immutable"))
+	// The Private Network can be enabled on a running cluster, but it cannot
+	// be changed or disabled once enabled.
+	if old.Spec.Network.PrivateNetwork != nil && old.Spec.Network.PrivateNetwork.Enabled &&
+		!reflect.DeepEqual(r.Spec.Network.PrivateNetwork, old.Spec.Network.PrivateNetwork) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "privateNetwork"), r.Spec.Network.PrivateNetwork, "field is immutable once enabled"))
+	}
+
+	// The Public Gateway can be enabled on a running cluster. Once enabled,
+	// only the bastion and the PAT rules are mutable.
+	oldPublicGateway := old.Spec.Network.PublicGateway.DeepCopy()
+	newPublicGateway := r.Spec.Network.PublicGateway.DeepCopy()
+
+	if oldPublicGateway != nil {
+		oldPublicGateway.Bastion = nil
+		oldPublicGateway.PATRules = nil
+	}
+
+	if newPublicGateway != nil {
+		newPublicGateway.Bastion = nil
+		newPublicGateway.PATRules = nil
+	}
+
+	if oldPublicGateway != nil && oldPublicGateway.Enabled && !reflect.DeepEqual(newPublicGateway, oldPublicGateway) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "network", "publicGateway"), r.Spec.Network.PublicGateway, "field is immutable once enabled"))
 	}
 
 	if old.Spec.ControlPlaneLoadBalancer == nil {
@@ -298,6 +801,10 @@ func (r *ScalewayCluster) enforceImmutability(old *ScalewayCluster) error {
 		r.Spec.ControlPlaneLoadBalancer = &LoadBalancerSpec{}
 	}
 
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.Disabled, r.Spec.ControlPlaneLoadBalancer.Disabled) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "disabled"), r.Spec.ControlPlaneLoadBalancer.Disabled, "field is immutable"))
+	}
+
 	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.Zone, r.Spec.ControlPlaneLoadBalancer.Zone) {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "zone"), r.Spec.ControlPlaneLoadBalancer.Zone, "field is immutable"))
 	}
@@ -306,6 +813,38 @@ func (r *ScalewayCluster) enforceImmutability(old *ScalewayCluster) error {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "ip"), r.Spec.ControlPlaneLoadBalancer.IP, "field is immutable"))
 	}
 
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.Port, r.Spec.ControlPlaneLoadBalancer.Port) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "port"), r.Spec.ControlPlaneLoadBalancer.Port, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.IPv6, r.Spec.ControlPlaneLoadBalancer.IPv6) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "ipv6"), r.Spec.ControlPlaneLoadBalancer.IPv6, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.EndpointIPFamily, r.Spec.ControlPlaneLoadBalancer.EndpointIPFamily) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "endpointIPFamily"), r.Spec.ControlPlaneLoadBalancer.EndpointIPFamily, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.Private, r.Spec.ControlPlaneLoadBalancer.Private) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "private"), r.Spec.ControlPlaneLoadBalancer.Private, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.PrivateIP, r.Spec.ControlPlaneLoadBalancer.PrivateIP) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "privateIP"), r.Spec.ControlPlaneLoadBalancer.PrivateIP, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.ID, r.Spec.ControlPlaneLoadBalancer.ID) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "id"), r.Spec.ControlPlaneLoadBalancer.ID, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.FrontendID, r.Spec.ControlPlaneLoadBalancer.FrontendID) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "frontendID"), r.Spec.ControlPlaneLoadBalancer.FrontendID, "field is immutable"))
+	}
+
+	if !reflect.DeepEqual(old.Spec.ControlPlaneLoadBalancer.BackendID, r.Spec.ControlPlaneLoadBalancer.BackendID) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "controlPlaneLoadBalancer", "backendID"), r.Spec.ControlPlaneLoadBalancer.BackendID, "field is immutable"))
+	}
+
 	if allErrs == nil {
 		return nil
 	}
@@ -1,7 +1,11 @@
 package v1beta1
 
 import (
+	"testing"
+
 	. "github.com/onsi/ginkgo/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
 var _ = Describe("ScalewayCluster Webhook", func() {
@@ -21,3 +25,1133 @@ var _ = Describe("ScalewayCluster Webhook", func() {
 	})
 
 })
+
+func TestValidateExistingLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "existing loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{ID: scw.StringPtr("11111111-1111-1111-1111-111111111111")},
+			},
+		},
+		{
+			name: "existing loadbalancer, frontend and backend",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					ID:         scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+					FrontendID: scw.StringPtr("22222222-2222-2222-2222-222222222222"),
+					BackendID:  scw.StringPtr("33333333-3333-3333-3333-333333333333"),
+				},
+			},
+		},
+		{
+			name: "existing loadbalancer with IP",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					ID: scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+					IP: scw.StringPtr("51.15.0.1"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing frontend without backend",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					ID:         scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+					FrontendID: scw.StringPtr("22222222-2222-2222-2222-222222222222"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing frontend without loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{FrontendID: scw.StringPtr("22222222-2222-2222-2222-222222222222")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing backend without loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{BackendID: scw.StringPtr("33333333-3333-3333-3333-333333333333")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExistingSecurityGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		sg      SecurityGroup
+		wantErr bool
+	}{
+		{
+			name: "existing security group",
+			sg: SecurityGroup{
+				Name: "existing",
+				IDs:  map[string]string{"fr-par-1": "11111111-1111-1111-1111-111111111111"},
+			},
+		},
+		{
+			name: "existing security group with inbound policy",
+			sg: SecurityGroup{
+				Name:    "existing",
+				IDs:     map[string]string{"fr-par-1": "11111111-1111-1111-1111-111111111111"},
+				Inbound: &SecurityGroupPolicy{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing security group with outbound policy",
+			sg: SecurityGroup{
+				Name:     "existing",
+				IDs:      map[string]string{"fr-par-1": "11111111-1111-1111-1111-111111111111"},
+				Outbound: &SecurityGroupPolicy{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid zone",
+			sg: SecurityGroup{
+				Name: "existing",
+				IDs:  map[string]string{"fr-par": "11111111-1111-1111-1111-111111111111"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zone outside of the region",
+			sg: SecurityGroup{
+				Name: "existing",
+				IDs:  map[string]string{"nl-ams-1": "11111111-1111-1111-1111-111111111111"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{SecurityGroups: []SecurityGroup{tt.sg}},
+			}
+
+			if err := (&ScalewayCluster{Spec: spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePrivateLoadBalancer(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "private loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				Network:                  &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Private: scw.BoolPtr(true)},
+			},
+		},
+		{
+			name: "private loadbalancer without Private Network",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Private: scw.BoolPtr(true)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "private loadbalancer with public IP",
+			spec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					Private: scw.BoolPtr(true),
+					IP:      scw.StringPtr("51.15.0.1"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "private IP",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				Network:                  &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{PrivateIP: scw.StringPtr("172.16.0.10")},
+			},
+		},
+		{
+			name: "private IP without Private Network",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{PrivateIP: scw.StringPtr("172.16.0.10")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid private IP",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				Network:                  &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{PrivateIP: scw.StringPtr("172.16.0")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExtraListeners(t *testing.T) {
+	https := LoadBalancerHealthCheckProtocolHTTPS
+
+	tests := []struct {
+		name    string
+		lb      LoadBalancerSpec
+		wantErr bool
+	}{
+		{
+			name: "extra listeners",
+			lb: LoadBalancerSpec{ExtraListeners: []LoadBalancerListener{
+				{Name: "rke2", Port: 9345},
+				{Name: "konnectivity", Port: 8132, HealthCheck: &LoadBalancerHealthCheck{Protocol: &https, Path: scw.StringPtr("/healthz")}},
+			}},
+		},
+		{
+			name:    "reserved name",
+			lb:      LoadBalancerSpec{ExtraListeners: []LoadBalancerListener{{Name: "control-plane", Port: 9345}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate name",
+			lb: LoadBalancerSpec{ExtraListeners: []LoadBalancerListener{
+				{Name: "rke2", Port: 9345},
+				{Name: "rke2", Port: 9346},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate port",
+			lb: LoadBalancerSpec{ExtraListeners: []LoadBalancerListener{
+				{Name: "rke2", Port: 9345},
+				{Name: "talos", Port: 9345},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "same port as the control-plane frontend",
+			lb: LoadBalancerSpec{
+				Port:           scw.Int32Ptr(443),
+				ExtraListeners: []LoadBalancerListener{{Name: "rke2", Port: 443}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "path of a TCP health check",
+			lb: LoadBalancerSpec{ExtraListeners: []LoadBalancerListener{
+				{Name: "rke2", Port: 9345, HealthCheck: &LoadBalancerHealthCheck{Path: scw.StringPtr("/healthz")}},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &ScalewayCluster{Spec: ScalewayClusterSpec{Region: "fr-par", ControlPlaneLoadBalancer: &tt.lb}}
+			if err := c.validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateIPv6LoadBalancer(t *testing.T) {
+	ipv6 := IPFamilyIPv6
+
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "ipv6 loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{IPv6: scw.BoolPtr(true)},
+			},
+		},
+		{
+			name: "ipv6 endpoint",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{IPv6: scw.BoolPtr(true), EndpointIPFamily: &ipv6},
+			},
+		},
+		{
+			name: "ipv6 private loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				Network:                  &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Private: scw.BoolPtr(true), IPv6: scw.BoolPtr(true)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipv6 endpoint without ipv6",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{EndpointIPFamily: &ipv6},
+			},
+			wantErr: true,
+		},
+		{
+			name: "ipv6 endpoint of an existing loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					ID:               scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+					Zone:             scw.StringPtr(scw.ZoneFrPar1.String()),
+					EndpointIPFamily: &ipv6,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateDisabledLoadBalancer(t *testing.T) {
+	endpoint := clusterv1beta1.APIEndpoint{Host: "172.16.0.10", Port: 6443}
+
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "disabled loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneEndpoint:     endpoint,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Disabled: scw.BoolPtr(true)},
+			},
+		},
+		{
+			name: "disabled loadbalancer with the default type",
+			spec: ScalewayClusterSpec{
+				Region:               "fr-par",
+				ControlPlaneEndpoint: endpoint,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					Disabled: scw.BoolPtr(true),
+					Type:     scw.StringPtr("LB-S"),
+				},
+			},
+		},
+		{
+			name: "disabled loadbalancer without control-plane endpoint",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Disabled: scw.BoolPtr(true)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "disabled loadbalancer with other fields",
+			spec: ScalewayClusterSpec{
+				Region:               "fr-par",
+				ControlPlaneEndpoint: endpoint,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					Disabled:      scw.BoolPtr(true),
+					AllowedRanges: []string{"1.2.3.4/32"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "disabled loadbalancer with DNS",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneEndpoint:     endpoint,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Disabled: scw.BoolPtr(true)},
+				ControlPlaneDNS:          &ControlPlaneDNSSpec{Zone: "example.com", Name: "api"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "enabled loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Disabled: scw.BoolPtr(false)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateExtraZones(t *testing.T) {
+	dns := &ControlPlaneDNSSpec{Zone: "example.com", Name: "api"}
+
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "extra zones",
+			spec: ScalewayClusterSpec{
+				Region:          "fr-par",
+				ControlPlaneDNS: dns,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					Zone:       scw.StringPtr("fr-par-1"),
+					ExtraZones: []string{"fr-par-2"},
+				},
+			},
+		},
+		{
+			name: "extra zones without DNS",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{ExtraZones: []string{"fr-par-2"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extra zones with existing loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:          "fr-par",
+				ControlPlaneDNS: dns,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					ID:         scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+					Zone:       scw.StringPtr("fr-par-1"),
+					ExtraZones: []string{"fr-par-2"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extra zones with IP",
+			spec: ScalewayClusterSpec{
+				Region:          "fr-par",
+				ControlPlaneDNS: dns,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					IP:         scw.StringPtr("51.15.0.1"),
+					ExtraZones: []string{"fr-par-2"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extra zones with private loadbalancer",
+			spec: ScalewayClusterSpec{
+				Region:          "fr-par",
+				ControlPlaneDNS: dns,
+				Network:         &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					Private:    scw.BoolPtr(true),
+					ExtraZones: []string{"fr-par-2"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extra zone in another region",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneDNS:          dns,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{ExtraZones: []string{"nl-ams-1"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid extra zone",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneDNS:          dns,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{ExtraZones: []string{"fr-par"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extra zone is the main zone",
+			spec: ScalewayClusterSpec{
+				Region:          "fr-par",
+				ControlPlaneDNS: dns,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{
+					Zone:       scw.StringPtr("fr-par-1"),
+					ExtraZones: []string{"fr-par-1"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate extra zones",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneDNS:          dns,
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{ExtraZones: []string{"fr-par-2", "fr-par-2"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateUnsupportedZones(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "supported zones",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Zone: scw.StringPtr("fr-par-2")},
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true, Zone: scw.StringPtr("fr-par-2")},
+				},
+			},
+		},
+		{
+			name: "loadbalancer in an unsupported zone",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{Zone: scw.StringPtr("fr-par-3")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "extra zone is unsupported",
+			spec: ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneDNS:          &ControlPlaneDNSSpec{Zone: "example.com", Name: "api"},
+				ControlPlaneLoadBalancer: &LoadBalancerSpec{ExtraZones: []string{"fr-par-3"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "public gateway in an unsupported zone",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true, Zone: scw.StringPtr("fr-par-3")},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateFailureDomains(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "failure domains",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				FailureDomains: []FailureDomain{
+					{Zone: "fr-par-1"},
+					{Zone: "fr-par-2", ControlPlane: scw.BoolPtr(false), Attributes: map[string]string{"gpu": "true"}},
+				},
+			},
+		},
+		{
+			name: "invalid zone",
+			spec: ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []FailureDomain{{Zone: "fr-par"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "zone in another region",
+			spec: ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []FailureDomain{{Zone: "fr-par-1"}, {Zone: "nl-ams-1"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate zone",
+			spec: ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []FailureDomain{{Zone: "fr-par-1"}, {Zone: "fr-par-1", ControlPlane: scw.BoolPtr(false)}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no control-plane failure domain",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				FailureDomains: []FailureDomain{
+					{Zone: "fr-par-1", ControlPlane: scw.BoolPtr(false)},
+					{Zone: "fr-par-2", ControlPlane: scw.BoolPtr(false)},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateMultiZonePublicGateway(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "multi-zone public gateway",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway: &PublicGatewaySpec{
+						Enabled:   true,
+						MultiZone: scw.BoolPtr(true),
+						Zone:      scw.StringPtr("fr-par-2"),
+					},
+				},
+			},
+		},
+		{
+			name: "multi-zone public gateway with ID",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway: &PublicGatewaySpec{
+						Enabled:   true,
+						MultiZone: scw.BoolPtr(true),
+						ID:        scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+						Zone:      scw.StringPtr("fr-par-1"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "multi-zone public gateway with IP",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway: &PublicGatewaySpec{
+						Enabled:   true,
+						MultiZone: scw.BoolPtr(true),
+						IP:        scw.StringPtr("51.15.0.1"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "single-zone public gateway with IP",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway: &PublicGatewaySpec{
+						Enabled:   true,
+						MultiZone: scw.BoolPtr(false),
+						IP:        scw.StringPtr("51.15.0.1"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnforceBastionImmutability(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldSpec ScalewayClusterSpec
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "enable bastion",
+			oldSpec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PublicGateway: &PublicGatewaySpec{Enabled: true}},
+			},
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PublicGateway: &PublicGatewaySpec{
+					Enabled: true,
+					Bastion: &BastionSpec{Enabled: true},
+				}},
+			},
+		},
+		{
+			name: "change bastion port",
+			oldSpec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PublicGateway: &PublicGatewaySpec{
+					Enabled: true,
+					Bastion: &BastionSpec{Enabled: true, Port: scw.Uint32Ptr(61000)},
+				}},
+			},
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PublicGateway: &PublicGatewaySpec{
+					Enabled: true,
+					Bastion: &BastionSpec{Enabled: true, Port: scw.Uint32Ptr(2222)},
+				}},
+			},
+		},
+		{
+			name: "change public gateway type with bastion",
+			oldSpec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PublicGateway: &PublicGatewaySpec{
+					Enabled: true,
+					Bastion: &BastionSpec{Enabled: true},
+				}},
+			},
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PublicGateway: &PublicGatewaySpec{
+					Enabled: true,
+					Type:    scw.StringPtr("VPC-GW-M"),
+					Bastion: &BastionSpec{Enabled: true},
+				}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&ScalewayCluster{Spec: tt.spec}).enforceImmutability(&ScalewayCluster{Spec: tt.oldSpec})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("enforceImmutability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePATRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "PAT rules",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true, Subnet: scw.StringPtr("172.16.0.0/22")},
+					PublicGateway: &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{
+						{PublicPort: 2222, PrivatePort: scw.Uint32Ptr(22), Target: PATRuleTarget{PrivateIP: scw.StringPtr("172.16.0.2")}},
+						{PublicPort: 3333, Target: PATRuleTarget{ScalewayMachine: scw.StringPtr("bastion")}},
+					}},
+				},
+			},
+		},
+		{
+			name: "duplicate public port",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true, Subnet: scw.StringPtr("172.16.0.0/22")},
+					PublicGateway: &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{
+						{PublicPort: 2222, Target: PATRuleTarget{PrivateIP: scw.StringPtr("172.16.0.2")}},
+						{PublicPort: 2222, Target: PATRuleTarget{PrivateIP: scw.StringPtr("172.16.0.3")}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no target",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true, Subnet: scw.StringPtr("172.16.0.0/22")},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{{PublicPort: 2222}}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "both targets",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true, Subnet: scw.StringPtr("172.16.0.0/22")},
+					PublicGateway: &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{
+						{PublicPort: 2222, Target: PATRuleTarget{
+							PrivateIP:       scw.StringPtr("172.16.0.2"),
+							ScalewayMachine: scw.StringPtr("bastion"),
+						}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEnforceImmutability(t *testing.T) {
+	tests := []struct {
+		name    string
+		oldSpec ScalewayClusterSpec
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name:    "enable the Private Network",
+			oldSpec: ScalewayClusterSpec{Region: "fr-par"},
+			spec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+			},
+		},
+		{
+			name: "enable the Public Gateway",
+			oldSpec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+			},
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true},
+				},
+			},
+		},
+		{
+			name: "disable the Private Network",
+			oldSpec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+			},
+			spec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: false}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "remove the Private Network",
+			oldSpec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+			},
+			spec:    ScalewayClusterSpec{Region: "fr-par"},
+			wantErr: true,
+		},
+		{
+			name: "change the subnet of the Private Network",
+			oldSpec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true}},
+			},
+			spec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true, Subnet: scw.StringPtr("10.0.0.0/24")}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "disable the Public Gateway",
+			oldSpec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true},
+				},
+			},
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: false},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "change the type of the Public Gateway",
+			oldSpec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true},
+				},
+			},
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true, Type: scw.StringPtr("VPC-GW-M")},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "update the bastion and PAT rules of the Public Gateway",
+			oldSpec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway:  &PublicGatewaySpec{Enabled: true},
+				},
+			},
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway: &PublicGatewaySpec{
+						Enabled: true,
+						Bastion: &BastionSpec{Enabled: true},
+						PATRules: []PATRule{
+							{PublicPort: 2222, Target: PATRuleTarget{ScalewayMachine: scw.StringPtr("bastion")}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "change the region",
+			oldSpec: ScalewayClusterSpec{Region: "fr-par"},
+			spec:    ScalewayClusterSpec{Region: "nl-ams"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).enforceImmutability(&ScalewayCluster{Spec: tt.oldSpec}); (err != nil) != tt.wantErr {
+				t.Errorf("enforceImmutability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateControlPlaneIPs(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		wantErr bool
+	}{
+		{
+			name: "control-plane IPs in the subnet",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{
+					Enabled:         true,
+					Subnet:          scw.StringPtr("172.16.0.0/22"),
+					ControlPlaneIPs: []string{"172.16.0.10", "172.16.0.11"},
+				}},
+			},
+		},
+		{
+			name: "control-plane IPs with an unknown subnet",
+			spec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true, ControlPlaneIPs: []string{"10.0.0.10"}}},
+			},
+		},
+		{
+			name: "control-plane IP outside the subnet",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{
+					Enabled:         true,
+					Subnet:          scw.StringPtr("172.16.0.0/22"),
+					ControlPlaneIPs: []string{"172.16.0.10", "10.0.0.10"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicate control-plane IP",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{
+					Enabled:         true,
+					Subnet:          scw.StringPtr("172.16.0.0/22"),
+					ControlPlaneIPs: []string{"172.16.0.10", "172.16.0.10"},
+				}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid control-plane IP",
+			spec: ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &NetworkSpec{PrivateNetwork: &PrivateNetworkSpec{Enabled: true, ControlPlaneIPs: []string{"172.16.0"}}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePATRulesPrivateIP(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayClusterSpec
+		status  ScalewayClusterStatus
+		wantErr bool
+	}{
+		{
+			name: "IPv6 private IP",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true},
+					PublicGateway: &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{
+						{PublicPort: 2222, Target: PATRuleTarget{PrivateIP: scw.StringPtr("fd00::2")}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "private IP outside the subnet",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true, Subnet: scw.StringPtr("172.16.0.0/22")},
+					PublicGateway: &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{
+						{PublicPort: 2222, Target: PATRuleTarget{PrivateIP: scw.StringPtr("172.16.4.2")}},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "private IP in the subnet of an existing Private Network",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true, ID: scw.StringPtr("11111111-1111-1111-1111-111111111111")},
+					PublicGateway: &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{
+						{PublicPort: 2222, Target: PATRuleTarget{PrivateIP: scw.StringPtr("10.0.0.2")}},
+					}},
+				},
+			},
+			status: ScalewayClusterStatus{Network: &NetworkStatus{PrivateNetworkSubnets: []string{"10.0.0.0/24"}}},
+		},
+		{
+			name: "private IP outside the subnet of an existing Private Network",
+			spec: ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &NetworkSpec{
+					PrivateNetwork: &PrivateNetworkSpec{Enabled: true, ID: scw.StringPtr("11111111-1111-1111-1111-111111111111")},
+					PublicGateway: &PublicGatewaySpec{Enabled: true, PATRules: []PATRule{
+						{PublicPort: 2222, Target: PATRuleTarget{PrivateIP: scw.StringPtr("172.16.0.2")}},
+					}},
+				},
+			},
+			status:  ScalewayClusterStatus{Network: &NetworkStatus{PrivateNetworkSubnets: []string{"10.0.0.0/24"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayCluster{Spec: tt.spec, Status: tt.status}).validate(); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
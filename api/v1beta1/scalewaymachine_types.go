@@ -12,6 +12,10 @@ const MachineFinalizer = "scalewaymachine.infrastructure.cluster.x-k8s.io"
 
 // ScalewayMachineSpec defines the desired state of ScalewayMachine
 type ScalewayMachineSpec struct {
+	// ProviderID of the instance (e.g. scaleway://instance/<zone>/<id>). It is
+	// set by the provider once the instance is created. It can also be set at
+	// creation to adopt an existing instance instead of creating a new one:
+	// the instance must match the type and image of the ScalewayMachine.
 	// +optional
 	ProviderID *string `json:"providerID,omitempty"`
 
@@ -41,6 +45,14 @@ type ScalewayMachineSpec struct {
 	// If not set, the instance will be attached to the default security group.
 	// +optional
 	SecurityGroupName *string `json:"securityGroupName,omitempty"`
+
+	// PrivateIP is a static IP of the instance in the Private Network of the
+	// cluster. It is reserved through IPAM before the private NIC is created
+	// and released when the instance is deleted. Control-plane machines can
+	// instead get their IP from the controlPlaneIPs of the Private Network.
+	// +kubebuilder:validation:Format=ipv4
+	// +optional
+	PrivateIP *string `json:"privateIP,omitempty"`
 }
 
 // ScalewayRootVolumeType returns the volume type to use for the root volume.
@@ -67,6 +79,32 @@ type ScalewayMachineStatus struct {
 
 	// Addresses of the node.
 	Addresses []clusterv1.MachineAddress `json:"addresses,omitempty"`
+
+	// Zone of the server. It is chosen before the server is created and never
+	// changes, even if the failure domains of the cluster change.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+
+	// ID of the Instance server if available.
+	// +optional
+	ServerID *string `json:"serverID,omitempty"`
+
+	// ID of the public IP of the server if available.
+	// +optional
+	PublicIPID *string `json:"publicIPID,omitempty"`
+
+	// ID of the private NIC of the server if available.
+	// +optional
+	PrivateNICID *string `json:"privateNICID,omitempty"`
+
+	// ID of the IP that is reserved in IPAM for the private NIC if a static
+	// private IP is used.
+	// +optional
+	PrivateIPID *string `json:"privateIPID,omitempty"`
+
+	// IDs of the volumes of the server.
+	// +optional
+	VolumeIDs []string `json:"volumeIDs,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -1,13 +1,20 @@
 package v1beta1
 
 import (
+	"context"
+	"fmt"
+	"net/netip"
 	"reflect"
 
+	"github.com/google/uuid"
+
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -19,20 +26,65 @@ var scalewaymachinelog = logf.Log.WithName("scalewaymachine-resource")
 func (r *ScalewayMachine) SetupWebhookWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(r).
+		WithValidator(&scalewayMachineValidator{client: mgr.GetClient()}).
 		Complete()
 }
 
 //+kubebuilder:webhook:path=/validate-infrastructure-cluster-x-k8s-io-v1beta1-scalewaymachine,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachines,verbs=create;update,versions=v1beta1,name=vscalewaymachine.kb.io,admissionReviewVersions=v1
 
-var _ webhook.Validator = &ScalewayMachine{}
+// scalewayMachineValidator validates ScalewayMachines. It reads the
+// ScalewayCluster of the machine to validate the fields that depend on it.
+type scalewayMachineValidator struct {
+	client client.Reader
+}
+
+var _ webhook.CustomValidator = &scalewayMachineValidator{}
+
+// privateNetworkSubnets returns the IPv4 subnets of the Private Network of the
+// cluster of the ScalewayMachine. It returns nil if the cluster or its subnets
+// are not known yet.
+func (v *scalewayMachineValidator) privateNetworkSubnets(ctx context.Context, r *ScalewayMachine) ([]netip.Prefix, error) {
+	clusterName, ok := r.Labels[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil, nil
+	}
+
+	cluster := &clusterv1.Cluster{}
+	if err := v.client.Get(ctx, client.ObjectKey{Namespace: r.Namespace, Name: clusterName}, cluster); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
 
-func (r *ScalewayMachine) validate() error {
+	if cluster.Spec.InfrastructureRef == nil {
+		return nil, nil
+	}
+
+	scalewayCluster := &ScalewayCluster{}
+	if err := v.client.Get(ctx, client.ObjectKey{Namespace: r.Namespace, Name: cluster.Spec.InfrastructureRef.Name}, scalewayCluster); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+
+	return scalewayCluster.privateNetworkSubnets(), nil
+}
+
+func (r *ScalewayMachine) validate(subnets []netip.Prefix) error {
 	var allErrs field.ErrorList
 
+	if r.Spec.ProviderID != nil {
+		if err := validateProviderID(*r.Spec.ProviderID); err != nil {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "providerID"), r.Spec.ProviderID, err.Error()))
+		}
+	}
+
 	if r.Spec.RootVolumeSize != nil && *r.Spec.RootVolumeSize < 5 {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "rootVolumeSize"), r.Spec.RootVolumeSize, "must be at least 5 GB"))
 	}
 
+	if r.Spec.PrivateIP != nil {
+		if err := validatePrivateIP(*r.Spec.PrivateIP, subnets, field.NewPath("spec", "privateIP")); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
 	if allErrs == nil {
 		return nil
 	}
@@ -40,6 +92,21 @@ func (r *ScalewayMachine) validate() error {
 	return apierrors.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "ScalewayMachine"}, r.Name, allErrs)
 }
 
+// validateProviderID validates that the provider ID is in the
+// scaleway://instance/<zone>/<id> format.
+func validateProviderID(providerID string) error {
+	_, serverID, err := ParseProviderID(providerID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := uuid.Parse(serverID); err != nil {
+		return fmt.Errorf("invalid server ID: %w", err)
+	}
+
+	return nil
+}
+
 func (r *ScalewayMachine) enforceImmutability(old *ScalewayMachine) error {
 	var allErrs field.ErrorList
 
@@ -74,6 +141,10 @@ func (r *ScalewayMachine) enforceImmutability(old *ScalewayMachine) error {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "publicIP"), r.Spec.PublicIP, "field can only be set to false"))
 	}
 
+	if !reflect.DeepEqual(old.Spec.PrivateIP, r.Spec.PrivateIP) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "privateIP"), r.Spec.PrivateIP, "field is immutable"))
+	}
+
 	// Cannot change SecurityGroupName.
 	if !reflect.DeepEqual(old.Spec.SecurityGroupName, r.Spec.SecurityGroupName) {
 		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "securityGroupName"), r.Spec.SecurityGroupName, "field is immutable"))
@@ -86,25 +157,34 @@ func (r *ScalewayMachine) enforceImmutability(old *ScalewayMachine) error {
 	return apierrors.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "ScalewayCluster"}, r.Name, allErrs)
 }
 
-// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
-func (r *ScalewayMachine) ValidateCreate() (admission.Warnings, error) {
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type
+func (v *scalewayMachineValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	r := obj.(*ScalewayMachine)
 	scalewaymachinelog.Info("validate create", "name", r.Name)
-	return nil, r.validate()
+
+	subnets, err := v.privateNetworkSubnets(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, r.validate(subnets)
 }
 
-// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
-func (r *ScalewayMachine) ValidateUpdate(old runtime.Object) (admission.Warnings, error) {
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type
+func (v *scalewayMachineValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	r := newObj.(*ScalewayMachine)
 	scalewaymachinelog.Info("validate update", "name", r.Name)
 
-	if err := r.enforceImmutability(old.(*ScalewayMachine)); err != nil {
+	if err := r.enforceImmutability(oldObj.(*ScalewayMachine)); err != nil {
 		return nil, err
 	}
 
-	return nil, r.validate()
+	// The private IP is immutable, it was already validated on creation.
+	return nil, r.validate(nil)
 }
 
-// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
-func (r *ScalewayMachine) ValidateDelete() (admission.Warnings, error) {
-	scalewaymachinelog.Info("validate delete", "name", r.Name)
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type
+func (v *scalewayMachineValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	scalewaymachinelog.Info("validate delete", "name", obj.(*ScalewayMachine).Name)
 	return nil, nil
 }
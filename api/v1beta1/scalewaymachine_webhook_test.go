@@ -1,7 +1,11 @@
 package v1beta1
 
 import (
+	"net/netip"
+	"testing"
+
 	. "github.com/onsi/ginkgo/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 var _ = Describe("ScalewayMachine Webhook", func() {
@@ -21,3 +25,82 @@ var _ = Describe("ScalewayMachine Webhook", func() {
 	})
 
 })
+
+func TestValidateProviderID(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    ScalewayMachineSpec
+		wantErr bool
+	}{
+		{
+			name: "no provider ID",
+		},
+		{
+			name: "valid provider ID",
+			spec: ScalewayMachineSpec{ProviderID: scw.StringPtr("scaleway://instance/fr-par-2/11111111-1111-1111-1111-111111111111")},
+		},
+		{
+			name:    "invalid prefix",
+			spec:    ScalewayMachineSpec{ProviderID: scw.StringPtr("aws://instance/fr-par-2/11111111-1111-1111-1111-111111111111")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid zone",
+			spec:    ScalewayMachineSpec{ProviderID: scw.StringPtr("scaleway://instance/fr-par/11111111-1111-1111-1111-111111111111")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid server ID",
+			spec:    ScalewayMachineSpec{ProviderID: scw.StringPtr("scaleway://instance/fr-par-2/server")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayMachine{Spec: tt.spec}).validate(nil); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidatePrivateIP(t *testing.T) {
+	subnets := []netip.Prefix{netip.MustParsePrefix("172.16.0.0/22")}
+
+	tests := []struct {
+		name    string
+		spec    ScalewayMachineSpec
+		subnets []netip.Prefix
+		wantErr bool
+	}{
+		{
+			name:    "private IP in the subnet",
+			spec:    ScalewayMachineSpec{PrivateIP: scw.StringPtr("172.16.0.10")},
+			subnets: subnets,
+		},
+		{
+			name: "private IP with an unknown subnet",
+			spec: ScalewayMachineSpec{PrivateIP: scw.StringPtr("10.0.0.10")},
+		},
+		{
+			name:    "private IP outside the subnet",
+			spec:    ScalewayMachineSpec{PrivateIP: scw.StringPtr("10.0.0.10")},
+			subnets: subnets,
+			wantErr: true,
+		},
+		{
+			name:    "IPv6 private IP",
+			spec:    ScalewayMachineSpec{PrivateIP: scw.StringPtr("fd00::10")},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := (&ScalewayMachine{Spec: tt.spec}).validate(tt.subnets); (err != nil) != tt.wantErr {
+				t.Errorf("validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,18 +5,196 @@
 package v1beta1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	apiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionSpec) DeepCopyInto(out *BastionSpec) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionSpec.
+func (in *BastionSpec) DeepCopy() *BastionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneDNSSpec) DeepCopyInto(out *ControlPlaneDNSSpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneDNSSpec.
+func (in *ControlPlaneDNSSpec) DeepCopy() *ControlPlaneDNSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneDNSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FailureDomain) DeepCopyInto(out *FailureDomain) {
+	*out = *in
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Attributes != nil {
+		in, out := &in.Attributes, &out.Attributes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FailureDomain.
+func (in *FailureDomain) DeepCopy() *FailureDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(FailureDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerHealthCheck) DeepCopyInto(out *LoadBalancerHealthCheck) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(LoadBalancerHealthCheckProtocol)
+		**out = **in
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerHealthCheck.
+func (in *LoadBalancerHealthCheck) DeepCopy() *LoadBalancerHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerListener) DeepCopyInto(out *LoadBalancerListener) {
+	*out = *in
+	if in.BackendPort != nil {
+		in, out := &in.BackendPort, &out.BackendPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(LoadBalancerProtocol)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(LoadBalancerHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerListener.
+func (in *LoadBalancerListener) DeepCopy() *LoadBalancerListener {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerListener)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerListenerStatus) DeepCopyInto(out *LoadBalancerListenerStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerListenerStatus.
+func (in *LoadBalancerListenerStatus) DeepCopy() *LoadBalancerListenerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerListenerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.FrontendID != nil {
+		in, out := &in.FrontendID, &out.FrontendID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackendID != nil {
+		in, out := &in.BackendID, &out.BackendID
+		*out = new(string)
+		**out = **in
+	}
 	if in.Zone != nil {
 		in, out := &in.Zone, &out.Zone
 		*out = new(string)
 		**out = **in
 	}
+	if in.ExtraZones != nil {
+		in, out := &in.ExtraZones, &out.ExtraZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Type != nil {
 		in, out := &in.Type, &out.Type
 		*out = new(string)
@@ -27,6 +205,48 @@ func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6 != nil {
+		in, out := &in.IPv6, &out.IPv6
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EndpointIPFamily != nil {
+		in, out := &in.EndpointIPFamily, &out.EndpointIPFamily
+		*out = new(string)
+		**out = **in
+	}
+	if in.Private != nil {
+		in, out := &in.Private, &out.Private
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PrivateIP != nil {
+		in, out := &in.PrivateIP, &out.PrivateIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BackendPort != nil {
+		in, out := &in.BackendPort, &out.BackendPort
+		*out = new(int32)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(LoadBalancerHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExtraListeners != nil {
+		in, out := &in.ExtraListeners, &out.ExtraListeners
+		*out = make([]LoadBalancerListener, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AllowedRanges != nil {
 		in, out := &in.AllowedRanges, &out.AllowedRanges
 		*out = make([]string, len(*in))
@@ -44,6 +264,108 @@ func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
+	*out = *in
+	in.LoadBalancerZoneStatus.DeepCopyInto(&out.LoadBalancerZoneStatus)
+	if in.ExtraZones != nil {
+		in, out := &in.ExtraZones, &out.ExtraZones
+		*out = make([]LoadBalancerZoneStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerStatus.
+func (in *LoadBalancerStatus) DeepCopy() *LoadBalancerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerZoneStatus) DeepCopyInto(out *LoadBalancerZoneStatus) {
+	*out = *in
+	if in.LoadBalancerID != nil {
+		in, out := &in.LoadBalancerID, &out.LoadBalancerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPIDs != nil {
+		in, out := &in.IPIDs, &out.IPIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPs != nil {
+		in, out := &in.IPs, &out.IPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIP != nil {
+		in, out := &in.PrivateIP, &out.PrivateIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingBackendServers != nil {
+		in, out := &in.PendingBackendServers, &out.PendingBackendServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FrontendID != nil {
+		in, out := &in.FrontendID, &out.FrontendID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BackendID != nil {
+		in, out := &in.BackendID, &out.BackendID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ACLIDs != nil {
+		in, out := &in.ACLIDs, &out.ACLIDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Listeners != nil {
+		in, out := &in.Listeners, &out.Listeners
+		*out = make(map[string]LoadBalancerListenerStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.BackendServerIPs != nil {
+		in, out := &in.BackendServerIPs, &out.BackendServerIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerZoneStatus.
+func (in *LoadBalancerZoneStatus) DeepCopy() *LoadBalancerZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	*out = *in
@@ -84,10 +406,30 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.PublicGatewayID != nil {
-		in, out := &in.PublicGatewayID, &out.PublicGatewayID
-		*out = new(string)
-		**out = **in
+	if in.PrivateNetworkSubnets != nil {
+		in, out := &in.PrivateNetworkSubnets, &out.PrivateNetworkSubnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.PublicGatewayStatus.DeepCopyInto(&out.PublicGatewayStatus)
+	if in.ExtraPublicGateways != nil {
+		in, out := &in.ExtraPublicGateways, &out.ExtraPublicGateways
+		*out = make([]PublicGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(PrivateNetworkMigrationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecurityGroups != nil {
+		in, out := &in.SecurityGroups, &out.SecurityGroups
+		*out = make(map[string]SecurityGroupStatus, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
 	}
 }
 
@@ -101,6 +443,82 @@ func (in *NetworkStatus) DeepCopy() *NetworkStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PATRule) DeepCopyInto(out *PATRule) {
+	*out = *in
+	if in.PrivatePort != nil {
+		in, out := &in.PrivatePort, &out.PrivatePort
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(PATRuleProtocol)
+		**out = **in
+	}
+	in.Target.DeepCopyInto(&out.Target)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PATRule.
+func (in *PATRule) DeepCopy() *PATRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PATRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PATRuleTarget) DeepCopyInto(out *PATRuleTarget) {
+	*out = *in
+	if in.PrivateIP != nil {
+		in, out := &in.PrivateIP, &out.PrivateIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.ScalewayMachine != nil {
+		in, out := &in.ScalewayMachine, &out.ScalewayMachine
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PATRuleTarget.
+func (in *PATRuleTarget) DeepCopy() *PATRuleTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PATRuleTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PrivateNetworkMigrationStatus) DeepCopyInto(out *PrivateNetworkMigrationStatus) {
+	*out = *in
+	if in.PendingMachines != nil {
+		in, out := &in.PendingMachines, &out.PendingMachines
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PendingBackendServers != nil {
+		in, out := &in.PendingBackendServers, &out.PendingBackendServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateNetworkMigrationStatus.
+func (in *PrivateNetworkMigrationStatus) DeepCopy() *PrivateNetworkMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PrivateNetworkMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrivateNetworkSpec) DeepCopyInto(out *PrivateNetworkSpec) {
 	*out = *in
@@ -114,6 +532,11 @@ func (in *PrivateNetworkSpec) DeepCopyInto(out *PrivateNetworkSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ControlPlaneIPs != nil {
+		in, out := &in.ControlPlaneIPs, &out.ControlPlaneIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrivateNetworkSpec.
@@ -149,6 +572,23 @@ func (in *PublicGatewaySpec) DeepCopyInto(out *PublicGatewaySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.MultiZone != nil {
+		in, out := &in.MultiZone, &out.MultiZone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PATRules != nil {
+		in, out := &in.PATRules, &out.PATRules
+		*out = make([]PATRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicGatewaySpec.
@@ -161,6 +601,51 @@ func (in *PublicGatewaySpec) DeepCopy() *PublicGatewaySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicGatewayStatus) DeepCopyInto(out *PublicGatewayStatus) {
+	*out = *in
+	if in.PublicGatewayID != nil {
+		in, out := &in.PublicGatewayID, &out.PublicGatewayID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicGatewayIPID != nil {
+		in, out := &in.PublicGatewayIPID, &out.PublicGatewayIPID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicGatewayIP != nil {
+		in, out := &in.PublicGatewayIP, &out.PublicGatewayIP
+		*out = new(string)
+		**out = **in
+	}
+	if in.BastionPort != nil {
+		in, out := &in.BastionPort, &out.BastionPort
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.PublicGatewayZone != nil {
+		in, out := &in.PublicGatewayZone, &out.PublicGatewayZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.GatewayNetworkID != nil {
+		in, out := &in.GatewayNetworkID, &out.GatewayNetworkID
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicGatewayStatus.
+func (in *PublicGatewayStatus) DeepCopy() *PublicGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalewayCluster) DeepCopyInto(out *ScalewayCluster) {
 	*out = *in
@@ -226,8 +711,10 @@ func (in *ScalewayClusterSpec) DeepCopyInto(out *ScalewayClusterSpec) {
 	out.ControlPlaneEndpoint = in.ControlPlaneEndpoint
 	if in.FailureDomains != nil {
 		in, out := &in.FailureDomains, &out.FailureDomains
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+		*out = make([]FailureDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Network != nil {
 		in, out := &in.Network, &out.Network
@@ -239,6 +726,11 @@ func (in *ScalewayClusterSpec) DeepCopyInto(out *ScalewayClusterSpec) {
 		*out = new(LoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ControlPlaneDNS != nil {
+		in, out := &in.ControlPlaneDNS, &out.ControlPlaneDNS
+		*out = new(ControlPlaneDNSSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterSpec.
@@ -266,6 +758,11 @@ func (in *ScalewayClusterStatus) DeepCopyInto(out *ScalewayClusterStatus) {
 		*out = new(NetworkStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayClusterStatus.
@@ -456,6 +953,11 @@ func (in *ScalewayMachineSpec) DeepCopyInto(out *ScalewayMachineSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrivateIP != nil {
+		in, out := &in.PrivateIP, &out.PrivateIP
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineSpec.
@@ -476,6 +978,36 @@ func (in *ScalewayMachineStatus) DeepCopyInto(out *ScalewayMachineStatus) {
 		*out = make([]apiv1beta1.MachineAddress, len(*in))
 		copy(*out, *in)
 	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServerID != nil {
+		in, out := &in.ServerID, &out.ServerID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PublicIPID != nil {
+		in, out := &in.PublicIPID, &out.PublicIPID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateNICID != nil {
+		in, out := &in.PrivateNICID, &out.PrivateNICID
+		*out = new(string)
+		**out = **in
+	}
+	if in.PrivateIPID != nil {
+		in, out := &in.PrivateIPID, &out.PrivateIPID
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeIDs != nil {
+		in, out := &in.VolumeIDs, &out.VolumeIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalewayMachineStatus.
@@ -582,6 +1114,13 @@ func (in *ScalewayMachineTemplateSpec) DeepCopy() *ScalewayMachineTemplateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
+	if in.IDs != nil {
+		in, out := &in.IDs, &out.IDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Inbound != nil {
 		in, out := &in.Inbound, &out.Inbound
 		*out = new(SecurityGroupPolicy)
@@ -660,3 +1199,25 @@ func (in *SecurityGroupRule) DeepCopy() *SecurityGroupRule {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
+	*out = *in
+	if in.IDs != nil {
+		in, out := &in.IDs, &out.IDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
+func (in *SecurityGroupStatus) DeepCopy() *SecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
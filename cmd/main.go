@@ -4,6 +4,7 @@ import (
 	"crypto/tls"
 	"flag"
 	"os"
+	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -21,6 +22,7 @@ import (
 
 	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/controller"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -42,6 +44,9 @@ func main() {
 	var probeAddr string
 	var secureMetrics bool
 	var enableHTTP2 bool
+	var managementEgressIPs string
+	var managementEgressFromNodes bool
+	var managementEgressNodeSelector string
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
@@ -51,6 +56,15 @@ func main() {
 		"If set the metrics endpoint is served securely")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&managementEgressIPs, "management-cluster-egress-ips", "",
+		"Comma-separated list of egress IPs or CIDRs of the management cluster. "+
+			"They are allowed on the control-plane loadbalancers that restrict their allowed ranges.")
+	flag.BoolVar(&managementEgressFromNodes, "management-cluster-egress-from-nodes", false,
+		"If set, the external IPs of the nodes of the management cluster are allowed on the "+
+			"control-plane loadbalancers that restrict their allowed ranges.")
+	flag.StringVar(&managementEgressNodeSelector, "management-cluster-egress-node-selector", "",
+		"Label selector of the management cluster nodes whose external IPs are allowed "+
+			"when --management-cluster-egress-from-nodes is set. All nodes are selected by default.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -59,6 +73,17 @@ func main() {
 
 	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
 
+	var egressIPs []string
+	if managementEgressIPs != "" {
+		egressIPs = strings.Split(managementEgressIPs, ",")
+	}
+
+	managementEgress, err := scope.NewManagementEgress(egressIPs, managementEgressFromNodes, managementEgressNodeSelector)
+	if err != nil {
+		setupLog.Error(err, "invalid management cluster egress options")
+		os.Exit(1)
+	}
+
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
 	// prevent from being vulnerable to the HTTP/2 Stream Cancellation and
@@ -108,8 +133,9 @@ func main() {
 	}
 
 	if err = (&controller.ScalewayClusterReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:           mgr.GetClient(),
+		Scheme:           mgr.GetScheme(),
+		ManagementEgress: managementEgress,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ScalewayCluster")
 		os.Exit(1)
@@ -4,21 +4,32 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
 	scwClient "github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/dns"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/failuredomain"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/loadbalancer"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/securitygroup"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/vpc"
@@ -26,16 +37,23 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
+// publicGatewayHealthCheckInterval is the interval at which the health of the
+// Public Gateways is checked when there is a Public Gateway per zone.
+const publicGatewayHealthCheckInterval = time.Minute
+
 // ScalewayClusterReconciler reconciles a ScalewayCluster object
 type ScalewayClusterReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme           *runtime.Scheme
+	ManagementEgress *scope.ManagementEgress
 }
 
 //+kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters;clusters/status,verbs=get;list;watch
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusters,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusters/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewayclusters/finalizers,verbs=update
+//+kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=scalewaymachines,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch
 
 func (r *ScalewayClusterReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, retErr error) {
 	l := log.FromContext(ctx)
@@ -76,10 +94,11 @@ func (r *ScalewayClusterReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	clusterScope, err := scope.NewCluster(&scope.ClusterParams{
-		Client:          r.Client,
-		ScalewayCluster: scalewayCluster,
-		Cluster:         cluster,
-		ScalewayClient:  scwClient.New(c),
+		Client:           r.Client,
+		ScalewayCluster:  scalewayCluster,
+		Cluster:          cluster,
+		ScalewayClient:   scwClient.New(c),
+		ManagementEgress: r.ManagementEgress,
 	})
 	if err != nil {
 		return ctrl.Result{}, err
@@ -91,6 +110,19 @@ func (r *ScalewayClusterReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}()
 
+	if clusterScope.IsExternallyManaged() {
+		return r.reconcileExternallyManaged(ctx, clusterScope)
+	}
+
+	// The status is empty when the ScalewayCluster was moved to this management
+	// cluster with clusterctl move. Restore the IDs of the existing resources
+	// to prevent creating duplicates.
+	if clusterScope.NeedsRestore() {
+		if err := r.reconcileRestore(ctx, clusterScope); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to restore status: %w", err)
+		}
+	}
+
 	if !scalewayCluster.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, clusterScope)
 	}
@@ -107,7 +139,9 @@ func (r *ScalewayClusterReconciler) reconcileNormal(ctx context.Context, cluster
 		}
 	}
 
-	clusterScope.ScalewayCluster.Status.FailureDomains = clusterScope.FailureDomains()
+	if err := failuredomain.NewService(clusterScope).Reconcile(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile failure domains: %w", err)
+	}
 
 	if err := securitygroup.NewService(clusterScope).Reconcile(ctx); err != nil {
 		return ctrl.Result{}, err
@@ -118,30 +152,129 @@ func (r *ScalewayClusterReconciler) reconcileNormal(ctx context.Context, cluster
 	}
 
 	// TODO: maybe wait for the gateway to be ready?
-	if err := vpcgw.NewService(clusterScope).Reconcile(ctx); err != nil {
-		return ctrl.Result{}, fmt.Errorf("failed to reconcile vpcgw: %w", err)
+	// The cluster remains available when the Public Gateway of a zone is not
+	// ready: the default route is advertised by another Public Gateway.
+	gwErr := vpcgw.NewService(clusterScope).Reconcile(ctx)
+	if gwErr != nil && !errors.Is(gwErr, vpcgw.ErrZoneNotReady) {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile vpcgw: %w", gwErr)
 	}
 
-	if err := loadbalancer.NewService(clusterScope).Reconcile(ctx); err != nil {
-		if errors.Is(err, loadbalancer.ErrLoadBalancerNotReady) {
+	// The cluster remains available when the loadbalancer of an extra zone is
+	// not ready: it is excluded from the DNS records until it becomes ready.
+	lbErr := loadbalancer.NewService(clusterScope).Reconcile(ctx)
+	if lbErr != nil && !errors.Is(lbErr, loadbalancer.ErrExtraZoneNotReady) {
+		if errors.Is(lbErr, loadbalancer.ErrLoadBalancerNotReady) {
 			l.Info("loadbalancer is not ready yet, retrying")
 			return ctrl.Result{RequeueAfter: 2 * time.Second}, nil
 		}
-		return ctrl.Result{}, fmt.Errorf("failed to reconcile loadbalancer: %w", err)
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile loadbalancer: %w", lbErr)
+	}
+
+	if err := dns.NewService(clusterScope).Reconcile(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile dns: %w", err)
+	}
+
+	if err := vpc.NewService(clusterScope).ReconcileMigration(ctx); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile Private Network migration: %w", err)
 	}
 
 	clusterScope.ScalewayCluster.Status.Ready = true
 
+	if lbErr != nil {
+		l.Info("loadbalancer of an extra zone is not ready yet, retrying", "err", lbErr)
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	if gwErr != nil {
+		l.Info("Public Gateway of a zone is not ready yet, retrying", "err", gwErr)
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
+	if migration := clusterScope.NetworkStatus().Migration; migration != nil && !migration.Completed {
+		l.Info("machines are being migrated to the Private Network, retrying",
+			"pendingMachines", migration.PendingMachines,
+			"pendingBackendServers", migration.PendingBackendServers)
+		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
+	}
+
 	l.Info("Reconciled cluster successfully")
 
+	// The health of the Public Gateways is checked periodically so that the
+	// default route is moved when a Public Gateway becomes unhealthy.
+	if clusterScope.HasMultiZonePublicGateway() {
+		return ctrl.Result{RequeueAfter: publicGatewayHealthCheckInterval}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileExternallyManaged reconciles a ScalewayCluster whose infrastructure
+// is managed by an external system. No resource is created or deleted: the
+// ScalewayCluster is ready as soon as the control-plane endpoint is set.
+func (r *ScalewayClusterReconciler) reconcileExternallyManaged(ctx context.Context, clusterScope *scope.Cluster) (ctrl.Result, error) {
+	l := log.FromContext(ctx)
+
+	if !clusterScope.ScalewayCluster.DeletionTimestamp.IsZero() {
+		controllerutil.RemoveFinalizer(clusterScope.ScalewayCluster, infrastructurev1beta1.ClusterFinalizer)
+		return ctrl.Result{}, nil
+	}
+
+	clusterScope.ScalewayCluster.Status.FailureDomains = clusterScope.FailureDomains()
+
+	// The backend servers and the nodes ACLs are still managed when the IDs
+	// of the backend and the frontend are known.
+	if clusterScope.LoadBalancerStatus().BackendID != nil {
+		if err := loadbalancer.NewService(clusterScope).ReconcileBackendServers(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile loadbalancer backend servers: %w", err)
+		}
+	}
+
+	if clusterScope.LoadBalancerStatus().FrontendID != nil {
+		if err := loadbalancer.NewService(clusterScope).ReconcileNodesACLs(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to reconcile loadbalancer nodes ACLs: %w", err)
+		}
+	}
+
+	if !clusterScope.ScalewayCluster.Spec.ControlPlaneEndpoint.IsValid() {
+		l.Info("Waiting for the control-plane endpoint of the externally managed cluster")
+		clusterScope.ScalewayCluster.Status.Ready = false
+		return ctrl.Result{}, nil
+	}
+
+	clusterScope.ScalewayCluster.Status.Ready = true
+
+	l.Info("Reconciled externally managed cluster successfully")
+
 	return ctrl.Result{}, nil
 }
 
+func (r *ScalewayClusterReconciler) reconcileRestore(ctx context.Context, clusterScope *scope.Cluster) error {
+	log.FromContext(ctx).Info("Restoring cluster status from existing resources")
+
+	if err := vpc.NewService(clusterScope).Restore(ctx); err != nil {
+		return fmt.Errorf("failed to restore vpc: %w", err)
+	}
+
+	if err := vpcgw.NewService(clusterScope).Restore(ctx); err != nil {
+		return fmt.Errorf("failed to restore vpcgw: %w", err)
+	}
+
+	if err := loadbalancer.NewService(clusterScope).Restore(ctx); err != nil {
+		return fmt.Errorf("failed to restore loadbalancer: %w", err)
+	}
+
+	return nil
+}
+
 func (r *ScalewayClusterReconciler) reconcileDelete(ctx context.Context, clusterScope *scope.Cluster) (ctrl.Result, error) {
 	l := log.FromContext(ctx)
 
 	l.Info("Deleting cluster")
 
+	if err := dns.NewService(clusterScope).Delete(ctx); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	if err := loadbalancer.NewService(clusterScope).Delete(ctx); err != nil {
 		return ctrl.Result{}, err
 	}
@@ -170,7 +303,119 @@ func (r *ScalewayClusterReconciler) reconcileDelete(ctx context.Context, cluster
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ScalewayClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&infrastructurev1beta1.ScalewayCluster{}).
-		Complete(r)
+		Watches(
+			&infrastructurev1beta1.ScalewayMachine{},
+			handler.EnqueueRequestsFromMapFunc(r.scalewayMachineToScalewayCluster),
+			builder.WithPredicates(machineAddressesChanged()),
+		)
+
+	// Update the management cluster ACLs when the management nodes change.
+	if r.ManagementEgress != nil && r.ManagementEgress.FromNodes {
+		b = b.Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.nodeToScalewayClusters),
+			builder.WithPredicates(r.managementEgressChanged()),
+		)
+	}
+
+	return b.Complete(r)
+}
+
+// nodeToScalewayClusters maps a management cluster Node to all the
+// ScalewayClusters that are not externally managed.
+func (r *ScalewayClusterReconciler) nodeToScalewayClusters(ctx context.Context, _ client.Object) []reconcile.Request {
+	scalewayClusters := &infrastructurev1beta1.ScalewayClusterList{}
+	if err := r.List(ctx, scalewayClusters); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list ScalewayClusters")
+		return nil
+	}
+
+	var requests []reconcile.Request
+
+	for _, scalewayCluster := range scalewayClusters.Items {
+		if annotations.IsExternallyManaged(&scalewayCluster) {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&scalewayCluster),
+		})
+	}
+
+	return requests
+}
+
+// managementEgressChanged filters the Node events that don't change the
+// discovered egress IPs of the management cluster.
+func (r *ScalewayClusterReconciler) managementEgressChanged() predicate.Funcs {
+	nodeIPs := func(o client.Object) []string {
+		node, ok := o.(*corev1.Node)
+		if !ok {
+			return nil
+		}
+
+		return r.ManagementEgress.NodeIPs(node)
+	}
+
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			return len(nodeIPs(e.Object)) > 0
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return len(nodeIPs(e.Object)) > 0
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !reflect.DeepEqual(nodeIPs(e.ObjectOld), nodeIPs(e.ObjectNew))
+		},
+		GenericFunc: func(event.GenericEvent) bool {
+			return false
+		},
+	}
+}
+
+// scalewayMachineToScalewayCluster maps a ScalewayMachine to the ScalewayCluster
+// of its cluster, so that the loadbalancer backend servers and the nodes ACLs
+// are reconciled when the machines change.
+func (r *ScalewayClusterReconciler) scalewayMachineToScalewayCluster(ctx context.Context, o client.Object) []reconcile.Request {
+	clusterName, ok := o.GetLabels()[clusterv1.ClusterNameLabel]
+	if !ok {
+		return nil
+	}
+
+	cluster, err := util.GetClusterByName(ctx, r.Client, o.GetNamespace(), clusterName)
+	if err != nil {
+		return nil
+	}
+
+	ref := cluster.Spec.InfrastructureRef
+	if ref == nil || ref.Kind != "ScalewayCluster" {
+		return nil
+	}
+
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{Namespace: cluster.Namespace, Name: ref.Name},
+	}}
+}
+
+// machineAddressesChanged filters the ScalewayMachine updates that don't
+// change the addresses or the deletion timestamp of the machine.
+func machineAddressesChanged() predicate.Funcs {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldMachine, ok := e.ObjectOld.(*infrastructurev1beta1.ScalewayMachine)
+			if !ok {
+				return false
+			}
+
+			newMachine, ok := e.ObjectNew.(*infrastructurev1beta1.ScalewayMachine)
+			if !ok {
+				return false
+			}
+
+			return !reflect.DeepEqual(oldMachine.Status.Addresses, newMachine.Status.Addresses) ||
+				oldMachine.DeletionTimestamp.IsZero() != newMachine.DeletionTimestamp.IsZero()
+		},
+	}
 }
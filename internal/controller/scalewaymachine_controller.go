@@ -3,17 +3,25 @@ package controller
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
+	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
@@ -110,6 +118,15 @@ func (r *ScalewayMachineReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}()
 
+	// The status is empty when the ScalewayMachine was moved to this management
+	// cluster with clusterctl move. Restore the IDs of the existing resources
+	// to prevent creating duplicates.
+	if machineScope.NeedsRestore() {
+		if err := instance.NewService(machineScope).Restore(ctx); err != nil {
+			return ctrl.Result{}, fmt.Errorf("failed to restore status: %w", err)
+		}
+	}
+
 	if !scalewayMachine.ObjectMeta.DeletionTimestamp.IsZero() {
 		return r.reconcileDelete(ctx, machineScope)
 	}
@@ -131,6 +148,21 @@ func (r *ScalewayMachineReconciler) reconcileNormal(ctx context.Context, machine
 		return ctrl.Result{RequeueAfter: 2 * time.Second}, nil
 	}
 
+	// The failure domain of adopted servers comes from their provider ID.
+	if machineScope.ScalewayMachine.Spec.ProviderID == nil && machineScope.ScalewayMachine.Status.Zone == nil {
+		if err := machineScope.ValidateFailureDomain(); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	// Persist the zone before creating any resource, as the default zone
+	// depends on the failure domains of the cluster.
+	if machineScope.SetStatusZone() {
+		if err := machineScope.PatchObject(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
 	if err := instance.NewService(machineScope).Reconcile(ctx); err != nil {
 		if errors.Is(err, instance.ErrPrivateIPNotFound) {
 			l.Info("Private IP not available yet")
@@ -154,6 +186,11 @@ func (r *ScalewayMachineReconciler) reconcileNormal(ctx context.Context, machine
 
 func (r *ScalewayMachineReconciler) reconcileDelete(ctx context.Context, machineScope *scope.Machine) (ctrl.Result, error) {
 	if err := instance.NewService(machineScope).Delete(ctx); err != nil {
+		if errors.Is(err, instance.ErrServerDeleting) {
+			log.FromContext(ctx).Info("Server is being deleted")
+			return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+		}
+
 		return ctrl.Result{}, err
 	}
 
@@ -166,5 +203,70 @@ func (r *ScalewayMachineReconciler) reconcileDelete(ctx context.Context, machine
 func (r *ScalewayMachineReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&infrastructurev1beta1.ScalewayMachine{}).
+		// Attach the existing machines when a Private Network is enabled on
+		// a running cluster.
+		Watches(
+			&infrastructurev1beta1.ScalewayCluster{},
+			handler.EnqueueRequestsFromMapFunc(r.scalewayClusterToScalewayMachines),
+			builder.WithPredicates(privateNetworkIDChanged()),
+		).
 		Complete(r)
 }
+
+// scalewayClusterToScalewayMachines maps a ScalewayCluster to the
+// ScalewayMachines of its cluster.
+func (r *ScalewayMachineReconciler) scalewayClusterToScalewayMachines(ctx context.Context, o client.Object) []reconcile.Request {
+	cluster, err := util.GetOwnerCluster(ctx, r.Client, o.(*infrastructurev1beta1.ScalewayCluster).ObjectMeta)
+	if err != nil || cluster == nil {
+		return nil
+	}
+
+	machines := &infrastructurev1beta1.ScalewayMachineList{}
+	if err := r.List(ctx, machines,
+		client.InNamespace(o.GetNamespace()),
+		client.MatchingLabels{clusterv1.ClusterNameLabel: cluster.Name},
+	); err != nil {
+		log.FromContext(ctx).Error(err, "failed to list ScalewayMachines")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(machines.Items))
+	for _, machine := range machines.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: client.ObjectKeyFromObject(&machine),
+		})
+	}
+
+	return requests
+}
+
+// privateNetworkIDChanged filters the ScalewayCluster updates that don't
+// change the ID of the Private Network in the status.
+func privateNetworkIDChanged() predicate.Funcs {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return false },
+		DeleteFunc: func(event.DeleteEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldCluster, ok := e.ObjectOld.(*infrastructurev1beta1.ScalewayCluster)
+			if !ok {
+				return false
+			}
+
+			newCluster, ok := e.ObjectNew.(*infrastructurev1beta1.ScalewayCluster)
+			if !ok {
+				return false
+			}
+
+			var oldID, newID *string
+			if oldCluster.Status.Network != nil {
+				oldID = oldCluster.Status.Network.PrivateNetworkID
+			}
+
+			if newCluster.Status.Network != nil {
+				newID = newCluster.Status.Network.PrivateNetworkID
+			}
+
+			return !reflect.DeepEqual(oldID, newID)
+		},
+	}
+}
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	clusterctlv1 "sigs.k8s.io/cluster-api/cmd/clusterctl/api/v1alpha3"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
@@ -25,18 +26,40 @@ func clientFromSecret(ctx context.Context, client client.Client, scalewayCluster
 		return nil, err
 	}
 
-	// Take ownership of secret.
+	var needsUpdate bool
+
+	// Take ownership of secret. After a clusterctl move, the owner reference may
+	// point to the UID of the ScalewayCluster in the previous management cluster:
+	// the stale reference is removed before the new one is added.
 	if !metav1.IsControlledBy(secret, scalewayCluster) {
 		if !slices.ContainsFunc(secret.GetOwnerReferences(), func(o metav1.OwnerReference) bool {
 			return o.UID == scalewayCluster.UID
 		}) {
+			secret.SetOwnerReferences(slices.DeleteFunc(secret.GetOwnerReferences(), func(o metav1.OwnerReference) bool {
+				return o.Kind == "ScalewayCluster" && o.Name == scalewayCluster.Name
+			}))
+
 			if err := controllerutil.SetOwnerReference(scalewayCluster, secret, client.Scheme()); err != nil {
 				return nil, fmt.Errorf("failed to set owner reference for secret %s: %w", secret.Name, err)
 			}
 
-			if err := client.Update(ctx, secret); err != nil {
-				return nil, fmt.Errorf("failed to update secret %s: %w", secret.Name, err)
-			}
+			needsUpdate = true
+		}
+	}
+
+	// Make sure the secret is moved with the cluster by clusterctl move.
+	if _, ok := secret.Labels[clusterctlv1.ClusterctlMoveLabel]; !ok {
+		if secret.Labels == nil {
+			secret.Labels = make(map[string]string)
+		}
+
+		secret.Labels[clusterctlv1.ClusterctlMoveLabel] = ""
+		needsUpdate = true
+	}
+
+	if needsUpdate {
+		if err := client.Update(ctx, secret); err != nil {
+			return nil, fmt.Errorf("failed to update secret %s: %w", secret.Name, err)
 		}
 	}
 
@@ -8,8 +8,10 @@ import (
 	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	scwClient "github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/annotations"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -21,7 +23,10 @@ type Cluster struct {
 	ScalewayClient  *scwClient.Client
 	ScalewayCluster *infrastructurev1beta1.ScalewayCluster
 	Cluster         *v1beta1.Cluster
-	patchHelper     *patch.Helper
+	// ManagementEgress is nil if management cluster egress IPs are not
+	// allowed automatically.
+	ManagementEgress *ManagementEgress
+	patchHelper      *patch.Helper
 }
 
 type ClusterParams struct {
@@ -29,6 +34,8 @@ type ClusterParams struct {
 	ScalewayClient  *scwClient.Client
 	ScalewayCluster *infrastructurev1beta1.ScalewayCluster
 	Cluster         *v1beta1.Cluster
+	// ManagementEgress is optional.
+	ManagementEgress *ManagementEgress
 }
 
 func NewCluster(params *ClusterParams) (*Cluster, error) {
@@ -38,11 +45,12 @@ func NewCluster(params *ClusterParams) (*Cluster, error) {
 	}
 
 	return &Cluster{
-		Client:          params.Client,
-		ScalewayClient:  params.ScalewayClient,
-		ScalewayCluster: params.ScalewayCluster,
-		Cluster:         params.Cluster,
-		patchHelper:     helper,
+		Client:           params.Client,
+		ScalewayClient:   params.ScalewayClient,
+		ScalewayCluster:  params.ScalewayCluster,
+		Cluster:          params.Cluster,
+		ManagementEgress: params.ManagementEgress,
+		patchHelper:      helper,
 	}, nil
 }
 
@@ -83,45 +91,70 @@ func (c *Cluster) Zones(compatible []scw.Zone) (zones []scw.Zone) {
 	return zones
 }
 
-// FailureDomains returns the Failure Domains for this cluster.
+// FailureDomains returns the Failure Domains for this cluster, with the
+// attributes that are set in the spec. If no failure domain is set in the spec,
+// all the zones of the region are returned and allow control-plane machines.
 func (c *Cluster) FailureDomains() v1beta1.FailureDomains {
-	zones := c.Zones(nil)
-	failureDomains := make(v1beta1.FailureDomains, len(zones))
-
-	for _, zone := range zones {
-		if len(c.ScalewayCluster.Spec.FailureDomains) > 0 {
-			for _, fd := range c.ScalewayCluster.Spec.FailureDomains {
-				if fd == zone.String() {
-					failureDomains[zone.String()] = v1beta1.FailureDomainSpec{
-						ControlPlane: true,
-					}
-				}
-			}
-		} else {
+	if len(c.ScalewayCluster.Spec.FailureDomains) == 0 {
+		zones := c.Zones(nil)
+		failureDomains := make(v1beta1.FailureDomains, len(zones))
+
+		for _, zone := range zones {
 			failureDomains[zone.String()] = v1beta1.FailureDomainSpec{
 				ControlPlane: true,
 			}
 		}
+
+		return failureDomains
+	}
+
+	failureDomains := make(v1beta1.FailureDomains, len(c.ScalewayCluster.Spec.FailureDomains))
+
+	for _, fd := range c.ScalewayCluster.Spec.FailureDomains {
+		var attributes map[string]string
+		if len(fd.Attributes) > 0 {
+			attributes = maps.Clone(fd.Attributes)
+		}
+
+		failureDomains[fd.Zone] = v1beta1.FailureDomainSpec{
+			ControlPlane: fd.ControlPlane == nil || *fd.ControlPlane,
+			Attributes:   attributes,
+		}
 	}
 
 	return failureDomains
 }
 
+// compatibleZone returns the first failure domain that is in the provided
+// supported zones. If there is none, the first supported zone of the region
+// is returned.
+func (c *Cluster) compatibleZone(supported []scw.Zone) scw.Zone {
+	for _, fd := range c.ScalewayCluster.Spec.FailureDomains {
+		if slices.Contains(supported, scw.Zone(fd.Zone)) {
+			return scw.Zone(fd.Zone)
+		}
+	}
+
+	return c.Zones(supported)[0]
+}
+
 // LoadBalancerZone returns the zone where the LoadBalancer should be created.
+// Once chosen, the zone is recorded in the status.
 func (c *Cluster) LoadBalancerZone() scw.Zone {
 	if c.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
 		c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Zone != nil {
 		return scw.Zone(*c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Zone)
 	}
 
-	// TODO: if first failure domain is not compatible this will fail.
-	if len(c.ScalewayCluster.Spec.FailureDomains) > 0 {
-		return scw.Zone(c.ScalewayCluster.Spec.FailureDomains[0])
+	if status := c.ScalewayCluster.Status.LoadBalancer; status != nil && status.Zone != "" {
+		return scw.Zone(status.Zone)
 	}
 
-	return c.DefaultZone()
+	return c.compatibleZone(c.ScalewayClient.LoadBalancer.Zones())
 }
 
+// PublicGatewayZone returns the zone where the Public Gateway should be
+// created. Once chosen, the zone is recorded in the status.
 func (c *Cluster) PublicGatewayZone() scw.Zone {
 	if c.ScalewayCluster.Spec.Network != nil &&
 		c.ScalewayCluster.Spec.Network.PublicGateway != nil &&
@@ -129,12 +162,34 @@ func (c *Cluster) PublicGatewayZone() scw.Zone {
 		return scw.Zone(*c.ScalewayCluster.Spec.Network.PublicGateway.Zone)
 	}
 
-	// TODO: if first failure domain is not compatible this will fail.
-	if len(c.ScalewayCluster.Spec.FailureDomains) > 0 {
-		return scw.Zone(c.ScalewayCluster.Spec.FailureDomains[0])
+	if status := c.ScalewayCluster.Status.Network; status != nil && status.PublicGatewayZone != nil {
+		return scw.Zone(*status.PublicGatewayZone)
 	}
 
-	return c.DefaultZone()
+	return c.compatibleZone(c.ScalewayClient.VPCGW.Zones())
+}
+
+// PublicGatewayZones returns the zones where Public Gateways should be created.
+// The first zone is the zone of the main Public Gateway. When multiZone is
+// enabled, the other failure domains that support Public Gateways follow.
+func (c *Cluster) PublicGatewayZones() []scw.Zone {
+	zones := []scw.Zone{c.PublicGatewayZone()}
+
+	if !c.HasMultiZonePublicGateway() {
+		return zones
+	}
+
+	failureDomains := maps.Keys(c.FailureDomains())
+	slices.Sort(failureDomains)
+
+	for _, fd := range failureDomains {
+		zone := scw.Zone(fd)
+		if slices.Contains(c.ScalewayClient.VPCGW.Zones(), zone) && !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+
+	return zones
 }
 
 // LoadBalancerType returns the type of the control-plane Load Balancer.
@@ -162,12 +217,90 @@ func (c *Cluster) HasPrivateNetwork() bool {
 		c.ScalewayCluster.Spec.Network.PrivateNetwork.Enabled
 }
 
+// HasPrivateLoadBalancer returns true if the control-plane loadbalancer must
+// only be reachable from the Private Network.
+func (c *Cluster) HasPrivateLoadBalancer() bool {
+	return c.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
+		c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Private != nil &&
+		*c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Private
+}
+
+// LoadBalancerZones returns the zones of the loadbalancers of the cluster. The
+// first zone is the zone of the main loadbalancer.
+func (c *Cluster) LoadBalancerZones() []scw.Zone {
+	zones := []scw.Zone{c.LoadBalancerZone()}
+
+	if c.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil {
+		for _, zone := range c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.ExtraZones {
+			if !slices.Contains(zones, scw.Zone(zone)) {
+				zones = append(zones, scw.Zone(zone))
+			}
+		}
+	}
+
+	return zones
+}
+
+// HasLoadBalancer returns false if the loadbalancer is disabled.
+func (c *Cluster) HasLoadBalancer() bool {
+	return c.ScalewayCluster.Spec.ControlPlaneLoadBalancer == nil ||
+		c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Disabled == nil ||
+		!*c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Disabled
+}
+
+// HasIPv6LoadBalancer returns true if a flexible IPv6 must be assigned to the
+// loadbalancer.
+func (c *Cluster) HasIPv6LoadBalancer() bool {
+	return c.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
+		c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.IPv6 != nil &&
+		*c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.IPv6
+}
+
+// LoadBalancerEndpointIPFamily returns the IP family of the control-plane
+// endpoint.
+func (c *Cluster) LoadBalancerEndpointIPFamily() string {
+	if c.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
+		c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.EndpointIPFamily != nil {
+		return *c.ScalewayCluster.Spec.ControlPlaneLoadBalancer.EndpointIPFamily
+	}
+
+	return infrastructurev1beta1.IPFamilyIPv4
+}
+
+// HasControlPlaneDNS returns true if DNS records must be created for the
+// control-plane endpoint.
+func (c *Cluster) HasControlPlaneDNS() bool {
+	return c.ScalewayCluster.Spec.ControlPlaneDNS != nil
+}
+
+// ControlPlaneDNSName returns the FQDN of the DNS records of the control-plane
+// endpoint.
+func (c *Cluster) ControlPlaneDNSName() string {
+	return fmt.Sprintf("%s.%s", c.ScalewayCluster.Spec.ControlPlaneDNS.Name, c.ScalewayCluster.Spec.ControlPlaneDNS.Zone)
+}
+
 func (c *Cluster) HasPublicGateway() bool {
 	return c.ScalewayCluster.Spec.Network != nil &&
 		c.ScalewayCluster.Spec.Network.PublicGateway != nil &&
 		c.ScalewayCluster.Spec.Network.PublicGateway.Enabled
 }
 
+// HasMultiZonePublicGateway returns true if a Public Gateway is created in
+// every failure domain of the cluster.
+func (c *Cluster) HasMultiZonePublicGateway() bool {
+	return c.HasPublicGateway() &&
+		c.ScalewayCluster.Spec.Network.PublicGateway.MultiZone != nil &&
+		*c.ScalewayCluster.Spec.Network.PublicGateway.MultiZone
+}
+
+// IsExternallyManaged returns true if the infrastructure of the cluster is
+// managed by an external system, as indicated by the "cluster.x-k8s.io/managed-by"
+// annotation. In this case, the cluster resources are neither created nor
+// deleted and the IDs in the status are provided by the external system.
+func (c *Cluster) IsExternallyManaged() bool {
+	return annotations.IsExternallyManaged(c.ScalewayCluster)
+}
+
 // Name returns the name that resources created for the cluster should have.
 func (c *Cluster) Name() string {
 	return fmt.Sprintf("caps-%s", c.ScalewayCluster.Name)
@@ -178,7 +311,8 @@ func (c *Cluster) PrivateNetworkID() (string, error) {
 		return "", errors.New("cluster has no Private Network")
 	}
 
-	if c.ScalewayCluster.Status.Network.PrivateNetworkID == nil {
+	if c.ScalewayCluster.Status.Network == nil ||
+		c.ScalewayCluster.Status.Network.PrivateNetworkID == nil {
 		return "", errors.New("PrivateNetworkID not found in ScalewayCluster status")
 	}
 
@@ -188,13 +322,39 @@ func (c *Cluster) PrivateNetworkID() (string, error) {
 // SetStatusPrivateNetworkID sets the Private Network ID in the status of the
 // ScalewayCluster object.
 func (c *Cluster) SetStatusPrivateNetworkID(pnID string) {
+	c.NetworkStatus().PrivateNetworkID = &pnID
+}
+
+// NetworkStatus returns the network status of the ScalewayCluster object. The
+// status is initialized if it is not set yet.
+func (c *Cluster) NetworkStatus() *infrastructurev1beta1.NetworkStatus {
 	if c.ScalewayCluster.Status.Network == nil {
-		c.ScalewayCluster.Status.Network = &infrastructurev1beta1.NetworkStatus{
-			PrivateNetworkID: &pnID,
-		}
-	} else {
-		c.ScalewayCluster.Status.Network.PrivateNetworkID = &pnID
+		c.ScalewayCluster.Status.Network = &infrastructurev1beta1.NetworkStatus{}
 	}
+
+	return c.ScalewayCluster.Status.Network
+}
+
+// LoadBalancerStatus returns the loadbalancer status of the ScalewayCluster
+// object. The status is initialized if it is not set yet.
+func (c *Cluster) LoadBalancerStatus() *infrastructurev1beta1.LoadBalancerStatus {
+	if c.ScalewayCluster.Status.LoadBalancer == nil {
+		c.ScalewayCluster.Status.LoadBalancer = &infrastructurev1beta1.LoadBalancerStatus{}
+	}
+
+	return c.ScalewayCluster.Status.LoadBalancer
+}
+
+// SecurityGroupID returns the ID of the security group with the provided name
+// (as specified in the ScalewayCluster object) in the provided zone, if it is
+// known in the status.
+func (c *Cluster) SecurityGroupID(name string, zone scw.Zone) (string, bool) {
+	if c.ScalewayCluster.Status.Network == nil {
+		return "", false
+	}
+
+	id, ok := c.ScalewayCluster.Status.Network.SecurityGroups[name].IDs[zone.String()]
+	return id, ok
 }
 
 func (c *Cluster) Tags() []string {
@@ -208,3 +368,39 @@ func (c *Cluster) Tags() []string {
 func (c *Cluster) SecurityGroupName(name string) string {
 	return fmt.Sprintf("%s-%s", c.Name(), name)
 }
+
+// NeedsRestore returns true if the status of the ScalewayCluster must be
+// restored from the existing resources. This is the case when the status is
+// empty but the control plane endpoint was already set, e.g. after the
+// ScalewayCluster was moved to another management cluster with clusterctl move.
+func (c *Cluster) NeedsRestore() bool {
+	return c.ScalewayCluster.Status.Network == nil &&
+		c.ScalewayCluster.Status.LoadBalancer == nil &&
+		c.ScalewayCluster.Spec.ControlPlaneEndpoint.IsValid()
+}
+
+// Machines returns the ScalewayMachines of the cluster that are not being
+// deleted.
+func (c *Cluster) Machines(ctx context.Context) ([]infrastructurev1beta1.ScalewayMachine, error) {
+	return c.listMachines(ctx)
+}
+
+// ControlPlaneMachines returns the control-plane ScalewayMachines of the
+// cluster that are not being deleted.
+func (c *Cluster) ControlPlaneMachines(ctx context.Context) ([]infrastructurev1beta1.ScalewayMachine, error) {
+	return c.listMachines(ctx, client.HasLabels{v1beta1.MachineControlPlaneLabel})
+}
+
+func (c *Cluster) listMachines(ctx context.Context, opts ...client.ListOption) ([]infrastructurev1beta1.ScalewayMachine, error) {
+	machines := &infrastructurev1beta1.ScalewayMachineList{}
+	if err := c.Client.List(ctx, machines, append([]client.ListOption{
+		client.InNamespace(c.ScalewayCluster.Namespace),
+		client.MatchingLabels{v1beta1.ClusterNameLabel: c.Cluster.Name},
+	}, opts...)...); err != nil {
+		return nil, fmt.Errorf("failed to list machines: %w", err)
+	}
+
+	return slices.DeleteFunc(machines.Items, func(m infrastructurev1beta1.ScalewayMachine) bool {
+		return !m.DeletionTimestamp.IsZero()
+	}), nil
+}
@@ -0,0 +1,276 @@
+package scope
+
+import (
+	"reflect"
+	"testing"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestClusterNeedsRestore(t *testing.T) {
+	endpoint := v1beta1.APIEndpoint{Host: "51.15.0.1", Port: 6443}
+
+	tests := []struct {
+		name   string
+		spec   infrastructurev1beta1.ScalewayClusterSpec
+		status infrastructurev1beta1.ScalewayClusterStatus
+		want   bool
+	}{
+		{
+			name: "new cluster",
+		},
+		{
+			name: "reconciled cluster",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{ControlPlaneEndpoint: endpoint},
+			status: infrastructurev1beta1.ScalewayClusterStatus{
+				LoadBalancer: &infrastructurev1beta1.LoadBalancerStatus{
+					LoadBalancerZoneStatus: infrastructurev1beta1.LoadBalancerZoneStatus{
+						LoadBalancerID: scw.StringPtr("11111111-1111-1111-1111-111111111111"),
+					},
+				},
+			},
+		},
+		{
+			name: "moved cluster",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{ControlPlaneEndpoint: endpoint},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cluster{
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{Spec: tt.spec, Status: tt.status},
+			}
+
+			if got := c.NeedsRestore(); got != tt.want {
+				t.Errorf("NeedsRestore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadBalancerZone(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   infrastructurev1beta1.ScalewayClusterSpec
+		status infrastructurev1beta1.ScalewayClusterStatus
+		want   scw.Zone
+	}{
+		{
+			name: "zone in spec",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{Zone: scw.StringPtr("fr-par-2")},
+			},
+			want: scw.ZoneFrPar2,
+		},
+		{
+			name: "zone in status",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []infrastructurev1beta1.FailureDomain{{Zone: "fr-par-1"}},
+			},
+			status: infrastructurev1beta1.ScalewayClusterStatus{
+				LoadBalancer: &infrastructurev1beta1.LoadBalancerStatus{
+					LoadBalancerZoneStatus: infrastructurev1beta1.LoadBalancerZoneStatus{Zone: "fr-par-2"},
+				},
+			},
+			want: scw.ZoneFrPar2,
+		},
+		{
+			name: "first compatible failure domain",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []infrastructurev1beta1.FailureDomain{{Zone: "fr-par-3"}, {Zone: "fr-par-2"}},
+			},
+			want: scw.ZoneFrPar2,
+		},
+		{
+			name: "no compatible failure domain",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []infrastructurev1beta1.FailureDomain{{Zone: "fr-par-3"}},
+			},
+			want: scw.ZoneFrPar1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cluster{
+				ScalewayClient:  fake.NewClient(t, fake.NewAPI()),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{Spec: tt.spec, Status: tt.status},
+			}
+
+			if got := c.LoadBalancerZone(); got != tt.want {
+				t.Errorf("LoadBalancerZone() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublicGatewayZone(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   infrastructurev1beta1.ScalewayClusterSpec
+		status infrastructurev1beta1.ScalewayClusterStatus
+		want   scw.Zone
+	}{
+		{
+			name: "zone in spec",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region: "fr-par",
+				Network: &infrastructurev1beta1.NetworkSpec{
+					PublicGateway: &infrastructurev1beta1.PublicGatewaySpec{Enabled: true, Zone: scw.StringPtr("fr-par-2")},
+				},
+			},
+			want: scw.ZoneFrPar2,
+		},
+		{
+			name: "zone in status",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []infrastructurev1beta1.FailureDomain{{Zone: "fr-par-1"}},
+			},
+			status: infrastructurev1beta1.ScalewayClusterStatus{
+				Network: &infrastructurev1beta1.NetworkStatus{
+					PublicGatewayStatus: infrastructurev1beta1.PublicGatewayStatus{PublicGatewayZone: scw.StringPtr("fr-par-2")},
+				},
+			},
+			want: scw.ZoneFrPar2,
+		},
+		{
+			name: "first compatible failure domain",
+			spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:         "fr-par",
+				FailureDomains: []infrastructurev1beta1.FailureDomain{{Zone: "fr-par-3"}, {Zone: "fr-par-2"}},
+			},
+			want: scw.ZoneFrPar2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cluster{
+				ScalewayClient:  fake.NewClient(t, fake.NewAPI()),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{Spec: tt.spec, Status: tt.status},
+			}
+
+			if got := c.PublicGatewayZone(); got != tt.want {
+				t.Errorf("PublicGatewayZone() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFailureDomains(t *testing.T) {
+	tests := []struct {
+		name           string
+		failureDomains []infrastructurev1beta1.FailureDomain
+		want           v1beta1.FailureDomains
+	}{
+		{
+			name: "all zones of the region",
+			want: v1beta1.FailureDomains{
+				"fr-par-1": {ControlPlane: true},
+				"fr-par-2": {ControlPlane: true},
+				"fr-par-3": {ControlPlane: true},
+			},
+		},
+		{
+			name: "control-plane flag and attributes",
+			failureDomains: []infrastructurev1beta1.FailureDomain{
+				{Zone: "fr-par-1"},
+				{Zone: "fr-par-2", ControlPlane: scw.BoolPtr(false), Attributes: map[string]string{"gpu": "true"}},
+			},
+			want: v1beta1.FailureDomains{
+				"fr-par-1": {ControlPlane: true},
+				"fr-par-2": {Attributes: map[string]string{"gpu": "true"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cluster{
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					Spec: infrastructurev1beta1.ScalewayClusterSpec{Region: "fr-par", FailureDomains: tt.failureDomains},
+				},
+			}
+
+			if got := c.FailureDomains(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FailureDomains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPublicGatewayZones(t *testing.T) {
+	tests := []struct {
+		name           string
+		zone           *string
+		multiZone      *bool
+		failureDomains []infrastructurev1beta1.FailureDomain
+		want           []scw.Zone
+	}{
+		{
+			name: "single zone",
+			failureDomains: []infrastructurev1beta1.FailureDomain{
+				{Zone: "fr-par-2"},
+				{Zone: "fr-par-1"},
+			},
+			want: []scw.Zone{scw.ZoneFrPar2},
+		},
+		{
+			name:      "multi-zone without failure domains",
+			multiZone: scw.BoolPtr(true),
+			want:      []scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2},
+		},
+		{
+			name:      "multi-zone with main zone",
+			zone:      scw.StringPtr("fr-par-2"),
+			multiZone: scw.BoolPtr(true),
+			failureDomains: []infrastructurev1beta1.FailureDomain{
+				{Zone: "fr-par-1"},
+				{Zone: "fr-par-2"},
+				{Zone: "fr-par-3"},
+			},
+			want: []scw.Zone{scw.ZoneFrPar2, scw.ZoneFrPar1},
+		},
+		{
+			name:      "multi-zone disabled",
+			multiZone: scw.BoolPtr(false),
+			want:      []scw.Zone{scw.ZoneFrPar1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &Cluster{
+				ScalewayClient: fake.NewClient(t, fake.NewAPI()),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					Spec: infrastructurev1beta1.ScalewayClusterSpec{
+						Region:         "fr-par",
+						FailureDomains: tt.failureDomains,
+						Network: &infrastructurev1beta1.NetworkSpec{
+							PublicGateway: &infrastructurev1beta1.PublicGatewaySpec{
+								Enabled:   true,
+								Zone:      tt.zone,
+								MultiZone: tt.multiZone,
+							},
+						},
+					},
+				},
+			}
+
+			if got := c.PublicGatewayZones(); !slices.Equal(got, tt.want) {
+				t.Errorf("PublicGatewayZones() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,16 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash/fnv"
 
 	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/patch"
 )
 
-var ErrBootstrapDataNotReady = errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
+var (
+	ErrBootstrapDataNotReady = errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
+	ErrInvalidFailureDomain  = errors.New("invalid failure domain")
+)
 
 type Machine struct {
 	Cluster
@@ -87,19 +93,115 @@ func (m *Machine) Tags() []string {
 	}
 }
 
+// Zone returns the zone of the machine. If the ScalewayMachine has a provider
+// ID (e.g. an existing server that is adopted), the zone of the server is used.
+// Otherwise, the zone stored in the status by SetStatusZone is used.
 func (m *Machine) Zone() scw.Zone {
+	if m.ScalewayMachine.Spec.ProviderID != nil {
+		if zone, _, err := infrastructurev1beta1.ParseProviderID(*m.ScalewayMachine.Spec.ProviderID); err == nil {
+			return zone
+		}
+	}
+
+	if m.ScalewayMachine.Status.Zone != nil {
+		return scw.Zone(*m.ScalewayMachine.Status.Zone)
+	}
+
 	if m.Machine.Spec.FailureDomain == nil {
-		return scw.Zone(fmt.Sprintf("%s-1", m.Cluster.Region()))
+		return m.defaultZone()
 	}
 
 	return scw.Zone(*m.Machine.Spec.FailureDomain)
 }
 
+// SetStatusZone stores the zone of the machine in the status, so that it does
+// not change if the failure domains of the cluster are updated. It returns
+// true if the status was updated.
+func (m *Machine) SetStatusZone() bool {
+	if m.ScalewayMachine.Status.Zone != nil {
+		return false
+	}
+
+	m.ScalewayMachine.Status.Zone = scw.StringPtr(m.Zone().String())
+
+	return true
+}
+
+// defaultZone returns the zone of a machine that has no failure domain. The
+// machines are spread across the failure domains of the cluster by hashing
+// their name. Control-plane machines are only spread across the failure
+// domains that allow them.
+func (m *Machine) defaultZone() scw.Zone {
+	var zones []string
+
+	for name, fd := range m.Cluster.FailureDomains() {
+		if fd.ControlPlane || !util.IsControlPlaneMachine(m.Machine) {
+			zones = append(zones, name)
+		}
+	}
+
+	if len(zones) == 0 {
+		return m.Cluster.DefaultZone()
+	}
+
+	slices.Sort(zones)
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(m.ScalewayMachine.Name))
+
+	return scw.Zone(zones[h.Sum32()%uint32(len(zones))])
+}
+
+// ValidateFailureDomain returns an error if the failure domain of the Machine
+// is not in the region of the cluster or is not one of the failure domains of
+// the cluster.
+func (m *Machine) ValidateFailureDomain() error {
+	if m.Machine.Spec.FailureDomain == nil {
+		return nil
+	}
+
+	fd := *m.Machine.Spec.FailureDomain
+
+	zone, err := scw.ParseZone(fd)
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidFailureDomain, fd, err)
+	}
+
+	region, err := zone.Region()
+	if err != nil {
+		return fmt.Errorf("%w %q: %w", ErrInvalidFailureDomain, fd, err)
+	}
+
+	if region != m.Cluster.Region() {
+		return fmt.Errorf("%w %q: must be in the cluster region %s", ErrInvalidFailureDomain, fd, m.Cluster.Region())
+	}
+
+	if _, ok := m.Cluster.FailureDomains()[fd]; !ok {
+		return fmt.Errorf("%w %q: must be one of the cluster failure domains", ErrInvalidFailureDomain, fd)
+	}
+
+	return nil
+}
+
 // Name returns the name that resources created for the machine should have.
 func (m *Machine) Name() string {
-	return fmt.Sprintf("caps-%s", m.ScalewayMachine.Name)
+	return MachineName(m.ScalewayMachine.Name)
+}
+
+// MachineName returns the name that resources created for the ScalewayMachine
+// with the provided name should have.
+func MachineName(name string) string {
+	return fmt.Sprintf("caps-%s", name)
 }
 
 func (m *Machine) ProviderID(serverID string) string {
 	return fmt.Sprintf("scaleway://instance/%s/%s", m.Zone(), serverID)
 }
+
+// NeedsRestore returns true if the status of the ScalewayMachine must be
+// restored from the existing server. This is the case when the status is empty
+// but the machine already has a provider ID, e.g. after the ScalewayMachine was
+// moved to another management cluster with clusterctl move.
+func (m *Machine) NeedsRestore() bool {
+	return m.ScalewayMachine.Status.ServerID == nil && m.ScalewayMachine.Spec.ProviderID != nil
+}
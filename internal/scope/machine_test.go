@@ -0,0 +1,189 @@
+package scope
+
+import (
+	"testing"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+func TestMachineNeedsRestore(t *testing.T) {
+	tests := []struct {
+		name   string
+		spec   infrastructurev1beta1.ScalewayMachineSpec
+		status infrastructurev1beta1.ScalewayMachineStatus
+		want   bool
+	}{
+		{
+			name: "new machine",
+		},
+		{
+			name:   "reconciled machine",
+			spec:   infrastructurev1beta1.ScalewayMachineSpec{ProviderID: scw.StringPtr("scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111")},
+			status: infrastructurev1beta1.ScalewayMachineStatus{ServerID: scw.StringPtr("11111111-1111-1111-1111-111111111111")},
+		},
+		{
+			name: "moved machine",
+			spec: infrastructurev1beta1.ScalewayMachineSpec{ProviderID: scw.StringPtr("scaleway://instance/fr-par-1/11111111-1111-1111-1111-111111111111")},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &Machine{
+				ScalewayMachine: &infrastructurev1beta1.ScalewayMachine{Spec: tt.spec, Status: tt.status},
+			}
+
+			if got := m.NeedsRestore(); got != tt.want {
+				t.Errorf("NeedsRestore() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestMachine returns a Machine scope of a cluster in fr-par with the
+// provided failure domains.
+func newTestMachine(name string, controlPlane bool, failureDomains []infrastructurev1beta1.FailureDomain) *Machine {
+	machine := &v1beta1.Machine{}
+	if controlPlane {
+		machine.Labels = map[string]string{v1beta1.MachineControlPlaneLabel: ""}
+	}
+
+	return &Machine{
+		Cluster: Cluster{
+			ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+				Spec: infrastructurev1beta1.ScalewayClusterSpec{
+					Region:         "fr-par",
+					FailureDomains: failureDomains,
+				},
+			},
+		},
+		ScalewayMachine: &infrastructurev1beta1.ScalewayMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+		},
+		Machine: machine,
+	}
+}
+
+func TestDefaultZone(t *testing.T) {
+	workers := []infrastructurev1beta1.FailureDomain{
+		{Zone: "fr-par-1"},
+		{Zone: "fr-par-2", ControlPlane: scw.BoolPtr(false)},
+		{Zone: "fr-par-3", ControlPlane: scw.BoolPtr(false)},
+	}
+
+	tests := []struct {
+		name           string
+		controlPlane   bool
+		failureDomains []infrastructurev1beta1.FailureDomain
+		wantZones      []scw.Zone
+	}{
+		{
+			name:      "all zones of the region",
+			wantZones: []scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2, scw.ZoneFrPar3},
+		},
+		{
+			name:           "single failure domain",
+			failureDomains: []infrastructurev1beta1.FailureDomain{{Zone: "fr-par-2"}},
+			wantZones:      []scw.Zone{scw.ZoneFrPar2},
+		},
+		{
+			name:           "worker machine",
+			failureDomains: workers,
+			wantZones:      []scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2, scw.ZoneFrPar3},
+		},
+		{
+			name:           "control-plane machine",
+			controlPlane:   true,
+			failureDomains: workers,
+			wantZones:      []scw.Zone{scw.ZoneFrPar1},
+		},
+		{
+			name:         "no control-plane failure domain",
+			controlPlane: true,
+			failureDomains: []infrastructurev1beta1.FailureDomain{
+				{Zone: "fr-par-2", ControlPlane: scw.BoolPtr(false)},
+			},
+			wantZones: []scw.Zone{scw.ZoneFrPar1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			zones := make(map[scw.Zone]struct{})
+
+			for _, name := range []string{"machine-a", "machine-b", "machine-c", "machine-d", "machine-e", "machine-f"} {
+				m := newTestMachine(name, tt.controlPlane, tt.failureDomains)
+
+				zone := m.defaultZone()
+				if !slices.Contains(tt.wantZones, zone) {
+					t.Fatalf("defaultZone() of %s = %s, want one of %v", name, zone, tt.wantZones)
+				}
+
+				if again := m.defaultZone(); again != zone {
+					t.Fatalf("defaultZone() of %s is not stable: %s != %s", name, zone, again)
+				}
+
+				zones[zone] = struct{}{}
+			}
+
+			if len(tt.wantZones) > 1 && len(zones) == 1 {
+				t.Errorf("defaultZone() did not spread the machines: %v", zones)
+			}
+		})
+	}
+}
+
+func TestZone(t *testing.T) {
+	tests := []struct {
+		name          string
+		providerID    *string
+		statusZone    *string
+		failureDomain *string
+		want          scw.Zone
+	}{
+		{
+			name:          "provider ID",
+			providerID:    scw.StringPtr("scaleway://instance/fr-par-3/11111111-1111-1111-1111-111111111111"),
+			statusZone:    scw.StringPtr("fr-par-2"),
+			failureDomain: scw.StringPtr("fr-par-1"),
+			want:          scw.ZoneFrPar3,
+		},
+		{
+			name:          "status zone",
+			statusZone:    scw.StringPtr("fr-par-2"),
+			failureDomain: scw.StringPtr("fr-par-1"),
+			want:          scw.ZoneFrPar2,
+		},
+		{
+			name:          "failure domain",
+			failureDomain: scw.StringPtr("fr-par-1"),
+			want:          scw.ZoneFrPar1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestMachine("machine", false, nil)
+			m.ScalewayMachine.Spec.ProviderID = tt.providerID
+			m.ScalewayMachine.Status.Zone = tt.statusZone
+			m.Machine.Spec.FailureDomain = tt.failureDomain
+
+			if got := m.Zone(); got != tt.want {
+				t.Errorf("Zone() = %s, want %s", got, tt.want)
+			}
+
+			if got, want := m.SetStatusZone(), tt.statusZone == nil; got != want {
+				t.Errorf("SetStatusZone() = %v, want %v", got, want)
+			}
+
+			if got := m.Zone(); got != tt.want {
+				t.Errorf("Zone() = %s after SetStatusZone(), want %s", got, tt.want)
+			}
+		})
+	}
+}
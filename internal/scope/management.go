@@ -0,0 +1,97 @@
+package scope
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"golang.org/x/exp/slices"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ManagementEgress contains the egress IPs of the management cluster, or the
+// way to discover them. These IPs are allowed on the control-plane
+// loadbalancers of the workload clusters that restrict their allowed ranges.
+type ManagementEgress struct {
+	// IPs is a static list of IPs or CIDRs.
+	IPs []string
+	// FromNodes enables the discovery of the external IPs of the nodes of
+	// the management cluster.
+	FromNodes bool
+	// NodeSelector selects the nodes whose external IPs are discovered.
+	NodeSelector labels.Selector
+}
+
+// NewManagementEgress validates the provided IPs and node selector and returns
+// a new ManagementEgress.
+func NewManagementEgress(ips []string, fromNodes bool, nodeSelector string) (*ManagementEgress, error) {
+	for _, ip := range ips {
+		if _, err := netip.ParsePrefix(ip); err == nil {
+			continue
+		}
+
+		if _, err := netip.ParseAddr(ip); err != nil {
+			return nil, fmt.Errorf("invalid egress IP or CIDR %q", ip)
+		}
+	}
+
+	selector, err := labels.Parse(nodeSelector)
+	if err != nil {
+		return nil, fmt.Errorf("invalid node selector: %w", err)
+	}
+
+	return &ManagementEgress{
+		IPs:          ips,
+		FromNodes:    fromNodes,
+		NodeSelector: selector,
+	}, nil
+}
+
+// Enabled returns true if management cluster egress IPs must be allowed.
+func (m *ManagementEgress) Enabled() bool {
+	return m != nil && (len(m.IPs) > 0 || m.FromNodes)
+}
+
+// NodeIPs returns the external IPs of the node if it is selected by the node
+// selector.
+func (m *ManagementEgress) NodeIPs(node *corev1.Node) []string {
+	if !m.FromNodes || !m.NodeSelector.Matches(labels.Set(node.Labels)) {
+		return nil
+	}
+
+	var ips []string
+
+	for _, address := range node.Status.Addresses {
+		if address.Type == corev1.NodeExternalIP {
+			ips = append(ips, address.Address)
+		}
+	}
+
+	return ips
+}
+
+// ManagementEgressIPs returns the sorted egress IPs of the management cluster.
+func (c *Cluster) ManagementEgressIPs(ctx context.Context) ([]string, error) {
+	if !c.ManagementEgress.Enabled() {
+		return nil, nil
+	}
+
+	ips := slices.Clone(c.ManagementEgress.IPs)
+
+	if c.ManagementEgress.FromNodes {
+		nodes := &corev1.NodeList{}
+		if err := c.Client.List(ctx, nodes, client.MatchingLabelsSelector{Selector: c.ManagementEgress.NodeSelector}); err != nil {
+			return nil, fmt.Errorf("failed to list management cluster nodes: %w", err)
+		}
+
+		for i := range nodes.Items {
+			ips = append(ips, c.ManagementEgress.NodeIPs(&nodes.Items[i])...)
+		}
+	}
+
+	slices.Sort(ips)
+
+	return slices.Compact(ips), nil
+}
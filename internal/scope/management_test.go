@@ -0,0 +1,127 @@
+package scope
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewManagementEgress(t *testing.T) {
+	tests := []struct {
+		name         string
+		ips          []string
+		nodeSelector string
+		wantErr      bool
+	}{
+		{
+			name: "no IPs",
+		},
+		{
+			name: "IPs and CIDRs",
+			ips:  []string{"51.15.0.1", "51.15.1.0/24", "2001:db8::/32"},
+		},
+		{
+			name:    "invalid IP",
+			ips:     []string{"51.15.0"},
+			wantErr: true,
+		},
+		{
+			name:         "node selector",
+			nodeSelector: "node-role.kubernetes.io/control-plane",
+		},
+		{
+			name:         "invalid node selector",
+			nodeSelector: "a b",
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewManagementEgress(tt.ips, false, tt.nodeSelector); (err != nil) != tt.wantErr {
+				t.Errorf("NewManagementEgress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestManagementEgressIPs(t *testing.T) {
+	node := func(name string, labels map[string]string, ips ...string) client.Object {
+		n := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+		for _, ip := range ips {
+			n.Status.Addresses = append(n.Status.Addresses, corev1.NodeAddress{Type: corev1.NodeExternalIP, Address: ip})
+		}
+		n.Status.Addresses = append(n.Status.Addresses, corev1.NodeAddress{Type: corev1.NodeInternalIP, Address: "10.0.0.1"})
+
+		return n
+	}
+
+	nodes := []client.Object{
+		node("node-1", map[string]string{"egress": "true"}, "51.15.0.2"),
+		node("node-2", map[string]string{"egress": "true"}, "51.15.0.1"),
+		node("node-3", nil, "51.15.0.3"),
+	}
+
+	tests := []struct {
+		name         string
+		ips          []string
+		fromNodes    bool
+		nodeSelector string
+		want         []string
+	}{
+		{
+			name: "disabled",
+		},
+		{
+			name: "static IPs",
+			ips:  []string{"51.15.1.0/24", "51.15.0.1"},
+			want: []string{"51.15.0.1", "51.15.1.0/24"},
+		},
+		{
+			name:      "all nodes",
+			fromNodes: true,
+			want:      []string{"51.15.0.1", "51.15.0.2", "51.15.0.3"},
+		},
+		{
+			name:         "selected nodes and static IPs",
+			ips:          []string{"51.15.0.1"},
+			fromNodes:    true,
+			nodeSelector: "egress=true",
+			want:         []string{"51.15.0.1", "51.15.0.2"},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			egress, err := NewManagementEgress(tt.ips, tt.fromNodes, tt.nodeSelector)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			c := &Cluster{
+				Client:           fake.NewClientBuilder().WithScheme(scheme).WithObjects(nodes...).Build(),
+				ManagementEgress: egress,
+			}
+
+			got, err := c.ManagementEgressIPs(context.Background())
+			if err != nil {
+				t.Fatalf("ManagementEgressIPs() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ManagementEgressIPs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
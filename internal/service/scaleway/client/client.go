@@ -3,13 +3,15 @@ package client
 import (
 	"errors"
 
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
 	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
-	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1alpha1"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/api/marketplace/v2"
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -26,6 +28,28 @@ type Client struct {
 	VPCGW         *vpcgw.API
 	IPAM          *ipam.API
 	PublicGateway *vpcgw.API
+	Domain        DomainAPI
+}
+
+// IsNotFoundError returns true if the error is a ResourceNotFoundError returned
+// by the Scaleway API.
+func IsNotFoundError(err error) bool {
+	var notFound *scw.ResourceNotFoundError
+	return errors.As(err, &notFound)
+}
+
+// MergeTags appends the tags that are missing to the current tags of a
+// resource. It returns false if no tag is missing.
+func MergeTags(current, tags []string) ([]string, bool) {
+	merged := slices.Clone(current)
+
+	for _, tag := range tags {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+
+	return merged, len(merged) != len(current)
 }
 
 // client MUST have a default project ID...
@@ -44,5 +68,6 @@ func New(client *scw.Client) *Client {
 		VPCGW:         vpcgw.NewAPI(client),
 		IPAM:          ipam.NewAPI(client),
 		PublicGateway: vpcgw.NewAPI(client),
+		Domain:        domain.NewAPI(client),
 	}
 }
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// DomainAPI is the part of the Scaleway Domains and DNS API that is used by the
+// provider. It can be replaced by fake.DomainAPI in tests.
+type DomainAPI interface {
+	ListDNSZoneRecords(req *domain.ListDNSZoneRecordsRequest, opts ...scw.RequestOption) (*domain.ListDNSZoneRecordsResponse, error)
+	UpdateDNSZoneRecords(req *domain.UpdateDNSZoneRecordsRequest, opts ...scw.RequestOption) (*domain.UpdateDNSZoneRecordsResponse, error)
+}
+
+var _ DomainAPI = &domain.API{}
+
+// FindDNSRecords returns the records of the DNS zone with the provided name and
+// type.
+func (c *Client) FindDNSRecords(ctx context.Context, zone, name string, recordType domain.RecordType) ([]*domain.Record, error) {
+	resp, err := c.Domain.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+		DNSZone: zone,
+		Name:    name,
+		Type:    recordType,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list DNS records: %w", err)
+	}
+
+	// The name filter may not be exact.
+	records := make([]*domain.Record, 0, len(resp.Records))
+	for _, record := range resp.Records {
+		if record.Name == name && record.Type == recordType {
+			records = append(records, record)
+		}
+	}
+
+	return records, nil
+}
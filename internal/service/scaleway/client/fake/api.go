@@ -0,0 +1,105 @@
+// Package fake provides fakes of the Scaleway APIs that are used by the
+// provider, for tests.
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+)
+
+// ProjectID is the default project of the clients returned by NewClient.
+const ProjectID = "11111111-1111-1111-1111-111111111111"
+
+// API is a fake of the Scaleway API. It serves the registered routes and
+// returns a not found error for any other request. The routes are indexed by
+// method and path, e.g. "GET /vpc/v2/regions/fr-par/private-networks/<id>".
+type API struct {
+	mu     sync.Mutex
+	routes map[string]http.HandlerFunc
+	calls  []string
+}
+
+// NewAPI returns an API without routes.
+func NewAPI() *API {
+	return &API{routes: make(map[string]http.HandlerFunc)}
+}
+
+// Handle registers the handler of a route.
+func (a *API) Handle(route string, handler http.HandlerFunc) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.routes[route] = handler
+}
+
+// JSON registers a route that always returns v.
+func (a *API) JSON(route string, v any) {
+	a.Handle(route, func(w http.ResponseWriter, _ *http.Request) {
+		WriteJSON(w, http.StatusOK, v)
+	})
+}
+
+// Called returns true if the route was requested at least once.
+func (a *API) Called(route string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return slices.Contains(a.calls, route)
+}
+
+// ServeHTTP implements http.Handler.
+func (a *API) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	route := r.Method + " " + r.URL.Path
+
+	a.mu.Lock()
+	a.calls = append(a.calls, route)
+	handler, ok := a.routes[route]
+	a.mu.Unlock()
+
+	if !ok {
+		WriteJSON(w, http.StatusNotFound, map[string]string{
+			"type":        "not_found",
+			"resource":    "unknown",
+			"resource_id": r.URL.Path,
+		})
+		return
+	}
+
+	handler(w, r)
+}
+
+// WriteJSON writes v as the JSON response of a request.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// NewClient returns a client of the Scaleway API that sends its requests to
+// handler. The server is closed at the end of the test.
+func NewClient(t *testing.T, handler http.Handler) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	scwClient, err := scw.NewClient(
+		scw.WithAPIURL(server.URL),
+		scw.WithAuth("SCWXXXXXXXXXXXXXXXXX", ProjectID),
+		scw.WithDefaultProjectID(ProjectID),
+		scw.WithDefaultRegion(scw.RegionFrPar),
+		scw.WithDefaultZone(scw.ZoneFrPar1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client.New(scwClient)
+}
@@ -0,0 +1,106 @@
+package fake
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// DomainAPI is an in-memory implementation of client.DomainAPI. It only
+// supports the changes that are used by the provider (set and delete).
+type DomainAPI struct {
+	mu      sync.Mutex
+	records map[string][]*domain.Record
+	nextID  int
+}
+
+var _ client.DomainAPI = &DomainAPI{}
+
+// NewDomainAPI returns a DomainAPI with the provided existing DNS zones.
+func NewDomainAPI(zones ...string) *DomainAPI {
+	f := &DomainAPI{records: make(map[string][]*domain.Record)}
+	for _, zone := range zones {
+		f.records[zone] = nil
+	}
+
+	return f
+}
+
+// ListDNSZoneRecords implements client.DomainAPI.
+func (f *DomainAPI) ListDNSZoneRecords(req *domain.ListDNSZoneRecordsRequest, _ ...scw.RequestOption) (*domain.ListDNSZoneRecordsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	zoneRecords, ok := f.records[req.DNSZone]
+	if !ok {
+		return nil, &scw.ResourceNotFoundError{Resource: "dns_zone", ResourceID: req.DNSZone}
+	}
+
+	resp := &domain.ListDNSZoneRecordsResponse{}
+	for _, record := range zoneRecords {
+		if (req.Name == "" || record.Name == req.Name) &&
+			(req.Type == domain.RecordTypeUnknown || record.Type == req.Type) {
+			r := *record
+			resp.Records = append(resp.Records, &r)
+		}
+	}
+
+	resp.TotalCount = uint32(len(resp.Records))
+
+	return resp, nil
+}
+
+// UpdateDNSZoneRecords implements client.DomainAPI.
+func (f *DomainAPI) UpdateDNSZoneRecords(req *domain.UpdateDNSZoneRecordsRequest, _ ...scw.RequestOption) (*domain.UpdateDNSZoneRecordsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	zoneRecords, ok := f.records[req.DNSZone]
+	if !ok {
+		return nil, &scw.ResourceNotFoundError{Resource: "dns_zone", ResourceID: req.DNSZone}
+	}
+
+	for _, change := range req.Changes {
+		switch {
+		case change.Set != nil && change.Set.IDFields != nil:
+			id := change.Set.IDFields
+			zoneRecords = deleteRecords(zoneRecords, func(r *domain.Record) bool {
+				return r.Name == id.Name && r.Type == id.Type
+			})
+
+			for _, record := range change.Set.Records {
+				f.nextID++
+				r := *record
+				r.ID = fmt.Sprintf("record-%d", f.nextID)
+				r.Name = id.Name
+				r.Type = id.Type
+				zoneRecords = append(zoneRecords, &r)
+			}
+		case change.Delete != nil && change.Delete.ID != nil:
+			zoneRecords = deleteRecords(zoneRecords, func(r *domain.Record) bool {
+				return r.ID == *change.Delete.ID
+			})
+		default:
+			return nil, errors.New("unsupported record change")
+		}
+	}
+
+	f.records[req.DNSZone] = zoneRecords
+
+	return &domain.UpdateDNSZoneRecordsResponse{}, nil
+}
+
+func deleteRecords(records []*domain.Record, del func(*domain.Record) bool) []*domain.Record {
+	result := records[:0]
+	for _, record := range records {
+		if !del(record) {
+			result = append(result, record)
+		}
+	}
+
+	return result
+}
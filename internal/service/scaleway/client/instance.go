@@ -81,3 +81,32 @@ func (c *Client) FindSecurityGroupByName(ctx context.Context, zone scw.Zone, nam
 
 	return nil, ErrNoItemFound
 }
+
+// FindAvailableServerTypes returns the server types that are available in the
+// zone. The server types that are in shortage are not returned.
+func (c *Client) FindAvailableServerTypes(ctx context.Context, zone scw.Zone) (map[string]*instance.ServerType, error) {
+	types, err := c.Instance.ListServersTypes(&instance.ListServersTypesRequest{
+		Zone: zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list server types: %w", err)
+	}
+
+	availability, err := c.Instance.GetServerTypesAvailability(&instance.GetServerTypesAvailabilityRequest{
+		Zone: zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server types availability: %w", err)
+	}
+
+	available := make(map[string]*instance.ServerType, len(types.Servers))
+	for name, serverType := range types.Servers {
+		if a, ok := availability.Servers[name]; ok && a.Availability == instance.ServerTypesAvailabilityShortage {
+			continue
+		}
+
+		available[name] = serverType
+	}
+
+	return available, nil
+}
@@ -2,9 +2,11 @@ package client
 
 import (
 	"context"
+	"fmt"
 
-	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1alpha1"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
 )
 
 func (c *Client) FindIPv4ByInstancePrivateNICID(ctx context.Context, region scw.Region, pnicID string) (*scw.IPNet, error) {
@@ -25,3 +27,66 @@ func (c *Client) FindIPv4ByInstancePrivateNICID(ctx context.Context, region scw.
 
 	return nil, ErrNoItemFound
 }
+
+// FindIPv4ByLoadBalancerID returns the IPv4 of the loadbalancer in the provided
+// Private Network.
+func (c *Client) FindIPv4ByLoadBalancerID(ctx context.Context, region scw.Region, lbID, pnID string) (*scw.IPNet, error) {
+	ips, err := c.IPAM.ListIPs(&ipam.ListIPsRequest{
+		Region:           region,
+		ProjectID:        &c.ProjectID,
+		PrivateNetworkID: &pnID,
+		ResourceType:     ipam.ResourceTypeLBServer,
+		ResourceID:       &lbID,
+		IsIPv6:           scw.BoolPtr(false),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ips.IPs) > 0 {
+		return &ips.IPs[0].Address, nil
+	}
+
+	return nil, ErrNoItemFound
+}
+
+// FindPrivateIPByTags returns the IP booked in the Private Network that has
+// all the provided tags.
+func (c *Client) FindPrivateIPByTags(ctx context.Context, region scw.Region, pnID string, tags []string) (*ipam.IP, error) {
+	ips, err := c.IPAM.ListIPs(&ipam.ListIPsRequest{
+		Region:           region,
+		ProjectID:        &c.ProjectID,
+		PrivateNetworkID: &pnID,
+		Tags:             tags,
+		IsIPv6:           scw.BoolPtr(false),
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list IPs: %w", err)
+	}
+
+	// IPAM returns the IPs that have at least one of the tags.
+	matching := slices.DeleteFunc(ips.IPs, func(ip *ipam.IP) bool {
+		return !hasTags(ip.Tags, tags)
+	})
+
+	if len(matching) == 0 {
+		return nil, ErrNoItemFound
+	}
+
+	if len(matching) > 1 {
+		return nil, fmt.Errorf("%w: found %d IPs", ErrTooManyItemsFound, len(matching))
+	}
+
+	return matching[0], nil
+}
+
+// hasTags returns true if current contains all the tags.
+func hasTags(current, tags []string) bool {
+	for _, tag := range tags {
+		if !slices.Contains(current, tag) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -26,6 +26,27 @@ func (c *Client) FindLoadBalancerByName(ctx context.Context, zone scw.Zone, name
 	return nil, ErrNoItemFound
 }
 
+func (c *Client) FindLoadBalancerByTags(ctx context.Context, zone scw.Zone, tags []string) (*lb.LB, error) {
+	lbs, err := c.LoadBalancer.ListLBs(&lb.ZonedAPIListLBsRequest{
+		Zone:      zone,
+		Tags:      tags,
+		ProjectID: &c.ProjectID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list loadbalancers: %w", err)
+	}
+
+	if len(lbs.LBs) == 0 {
+		return nil, ErrNoItemFound
+	}
+
+	if len(lbs.LBs) > 1 {
+		return nil, fmt.Errorf("%w: found %d loadbalancers", ErrTooManyItemsFound, len(lbs.LBs))
+	}
+
+	return lbs.LBs[0], nil
+}
+
 func (c *Client) FindLoadBalancerBackendByNames(ctx context.Context, zone scw.Zone, lbName, backendName string) (*lb.Backend, error) {
 	loadbalancer, err := c.FindLoadBalancerByName(ctx, zone, lbName)
 	if err != nil {
@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -25,3 +26,24 @@ func (c *Client) FindPrivateNetworkByName(ctx context.Context, region scw.Region
 
 	return nil, ErrNoItemFound
 }
+
+func (c *Client) FindPrivateNetworkByTags(ctx context.Context, region scw.Region, tags []string) (*vpc.PrivateNetwork, error) {
+	pns, err := c.VPC.ListPrivateNetworks(&vpc.ListPrivateNetworksRequest{
+		Region:    region,
+		Tags:      tags,
+		ProjectID: &c.ProjectID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Private Networks: %w", err)
+	}
+
+	if len(pns.PrivateNetworks) == 0 {
+		return nil, ErrNoItemFound
+	}
+
+	if len(pns.PrivateNetworks) > 1 {
+		return nil, fmt.Errorf("%w: found %d Private Networks", ErrTooManyItemsFound, len(pns.PrivateNetworks))
+	}
+
+	return pns.PrivateNetworks[0], nil
+}
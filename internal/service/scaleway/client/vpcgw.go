@@ -27,6 +27,27 @@ func (c *Client) FindGatewayByName(ctx context.Context, zone scw.Zone, name stri
 	return nil, ErrNoItemFound
 }
 
+func (c *Client) FindGatewayByTags(ctx context.Context, zone scw.Zone, tags []string) (*vpcgw.Gateway, error) {
+	gws, err := c.VPCGW.ListGateways(&vpcgw.ListGatewaysRequest{
+		Zone:      zone,
+		Tags:      tags,
+		ProjectID: &c.ProjectID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, fmt.Errorf("failed to list Public Gateways: %w", err)
+	}
+
+	if len(gws.Gateways) == 0 {
+		return nil, ErrNoItemFound
+	}
+
+	if len(gws.Gateways) > 1 {
+		return nil, fmt.Errorf("%w: found %d Public Gateways", ErrTooManyItemsFound, len(gws.Gateways))
+	}
+
+	return gws.Gateways[0], nil
+}
+
 func (c *Client) FindGatewayIP(ctx context.Context, zone scw.Zone, ip string) (*vpcgw.IP, error) {
 	ips, err := c.VPCGW.ListIPs(&vpcgw.ListIPsRequest{
 		Zone:      zone,
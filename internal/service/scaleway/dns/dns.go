@@ -0,0 +1,179 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+)
+
+// DefaultTTL is the default TTL of the DNS records.
+const DefaultTTL = 300
+
+type Service struct {
+	*scope.Cluster
+}
+
+func NewService(clusterScope *scope.Cluster) *Service {
+	return &Service{clusterScope}
+}
+
+// ttl returns the TTL of the records.
+func (s *Service) ttl() uint32 {
+	if s.ScalewayCluster.Spec.ControlPlaneDNS.TTL != nil {
+		return *s.ScalewayCluster.Spec.ControlPlaneDNS.TTL
+	}
+
+	return DefaultTTL
+}
+
+// targets returns the IPv4 and IPv6 addresses of the loadbalancers that the
+// records must point to. Only the loadbalancers that are ready are used.
+func (s *Service) targets() (ipv4 []string, ipv6 []string, err error) {
+	status := s.LoadBalancerStatus()
+
+	var addresses []string
+	for _, zoneStatus := range append([]v1beta1.LoadBalancerZoneStatus{status.LoadBalancerZoneStatus}, status.ExtraZones...) {
+		if !zoneStatus.Ready {
+			continue
+		}
+
+		if s.HasPrivateLoadBalancer() && zoneStatus.PrivateIP != nil {
+			addresses = append(addresses, *zoneStatus.PrivateIP)
+		} else {
+			addresses = append(addresses, zoneStatus.IPs...)
+		}
+	}
+
+	for _, address := range addresses {
+		ip, err := netip.ParseAddr(address)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse loadbalancer IP %q: %w", address, err)
+		}
+
+		if ip.Is4() {
+			ipv4 = append(ipv4, address)
+		} else {
+			ipv6 = append(ipv6, address)
+		}
+	}
+
+	slices.Sort(ipv4)
+	slices.Sort(ipv6)
+
+	return ipv4, ipv6, nil
+}
+
+// ensureRecords ensures the records of the provided type point to the provided
+// IPs. The records are deleted if there is no IP.
+func (s *Service) ensureRecords(ctx context.Context, recordType domain.RecordType, ips []string) error {
+	spec := s.ScalewayCluster.Spec.ControlPlaneDNS
+
+	records, err := s.ScalewayClient.FindDNSRecords(ctx, spec.Zone, spec.Name, recordType)
+	if err != nil {
+		return err
+	}
+
+	var changes []*domain.RecordChange
+
+	if len(ips) == 0 {
+		for _, record := range records {
+			changes = append(changes, &domain.RecordChange{
+				Delete: &domain.RecordChangeDelete{ID: scw.StringPtr(record.ID)},
+			})
+		}
+	} else if !recordsMatch(records, ips, s.ttl()) {
+		set := &domain.RecordChangeSet{
+			IDFields: &domain.RecordIdentifier{Name: spec.Name, Type: recordType},
+		}
+
+		for _, ip := range ips {
+			set.Records = append(set.Records, &domain.Record{
+				Name: spec.Name,
+				Type: recordType,
+				Data: ip,
+				TTL:  s.ttl(),
+			})
+		}
+
+		changes = append(changes, &domain.RecordChange{Set: set})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	if _, err := s.ScalewayClient.Domain.UpdateDNSZoneRecords(&domain.UpdateDNSZoneRecordsRequest{
+		DNSZone:                 spec.Zone,
+		Changes:                 changes,
+		DisallowNewZoneCreation: true,
+	}, scw.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to update %s records: %w", recordType, err)
+	}
+
+	return nil
+}
+
+// recordsMatch returns true if the records point to the IPs with the TTL.
+func recordsMatch(records []*domain.Record, ips []string, ttl uint32) bool {
+	data := make([]string, 0, len(records))
+	for _, record := range records {
+		if record.TTL != ttl {
+			return false
+		}
+
+		data = append(data, record.Data)
+	}
+
+	slices.Sort(data)
+
+	return slices.Equal(data, ips)
+}
+
+func (s *Service) Reconcile(ctx context.Context) error {
+	if !s.HasControlPlaneDNS() {
+		return nil
+	}
+
+	ipv4, ipv6, err := s.targets()
+	if err != nil {
+		return err
+	}
+
+	if len(ipv4) == 0 && len(ipv6) == 0 {
+		return errors.New("loadbalancer has no IPs")
+	}
+
+	if err := s.ensureRecords(ctx, domain.RecordTypeA, ipv4); err != nil {
+		return err
+	}
+
+	return s.ensureRecords(ctx, domain.RecordTypeAAAA, ipv6)
+}
+
+// Delete deletes the records of the control-plane. There is nothing to delete
+// if the DNS zone no longer exists.
+func (s *Service) Delete(ctx context.Context) error {
+	if !s.HasControlPlaneDNS() {
+		return nil
+	}
+
+	for _, recordType := range []domain.RecordType{domain.RecordTypeA, domain.RecordTypeAAAA} {
+		if err := s.ensureRecords(ctx, recordType, nil); err != nil {
+			if client.IsNotFoundError(err) {
+				return nil
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	domain "github.com/scaleway/scaleway-sdk-go/api/domain/v2beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	testZone = "example.com"
+	testName = "api"
+)
+
+// newTestService returns a Service that manages the records of testName in
+// testZone, with the provided loadbalancer status.
+func newTestService(domainAPI *fake.DomainAPI, lbStatus *v1beta1.LoadBalancerStatus) *Service {
+	return NewService(&scope.Cluster{
+		ScalewayClient: &client.Client{Domain: domainAPI},
+		ScalewayCluster: &v1beta1.ScalewayCluster{
+			Spec: v1beta1.ScalewayClusterSpec{
+				Region:          "fr-par",
+				ControlPlaneDNS: &v1beta1.ControlPlaneDNSSpec{Zone: testZone, Name: testName},
+			},
+			Status: v1beta1.ScalewayClusterStatus{LoadBalancer: lbStatus},
+		},
+	})
+}
+
+// records returns the sorted data of the records of the provided type and
+// fails the test if their TTL is not ttl.
+func records(t *testing.T, domainAPI *fake.DomainAPI, recordType domain.RecordType, ttl uint32) []string {
+	t.Helper()
+
+	resp, err := domainAPI.ListDNSZoneRecords(&domain.ListDNSZoneRecordsRequest{
+		DNSZone: testZone,
+		Name:    testName,
+		Type:    recordType,
+	})
+	if err != nil {
+		t.Fatalf("failed to list records: %s", err)
+	}
+
+	data := make([]string, 0, len(resp.Records))
+	for _, record := range resp.Records {
+		if record.TTL != ttl {
+			t.Errorf("record %s %s has TTL %d, expected %d", recordType, record.Data, record.TTL, ttl)
+		}
+
+		data = append(data, record.Data)
+	}
+
+	slices.Sort(data)
+
+	return data
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name     string
+		lbStatus *v1beta1.LoadBalancerStatus
+		private  bool
+		wantA    []string
+		wantAAAA []string
+		wantErr  bool
+	}{
+		{
+			name: "A and AAAA records",
+			lbStatus: &v1beta1.LoadBalancerStatus{
+				LoadBalancerZoneStatus: v1beta1.LoadBalancerZoneStatus{
+					Ready: true,
+					IPs:   []string{"2001:db8::1", "51.15.0.1"},
+				},
+			},
+			wantA:    []string{"51.15.0.1"},
+			wantAAAA: []string{"2001:db8::1"},
+		},
+		{
+			name: "only ready extra zones",
+			lbStatus: &v1beta1.LoadBalancerStatus{
+				LoadBalancerZoneStatus: v1beta1.LoadBalancerZoneStatus{
+					Ready: true,
+					IPs:   []string{"51.15.0.2"},
+				},
+				ExtraZones: []v1beta1.LoadBalancerZoneStatus{
+					{Ready: true, IPs: []string{"51.15.0.1"}},
+					{Ready: false, IPs: []string{"51.15.0.3"}},
+				},
+			},
+			wantA: []string{"51.15.0.1", "51.15.0.2"},
+		},
+		{
+			name: "private loadbalancer",
+			lbStatus: &v1beta1.LoadBalancerStatus{
+				LoadBalancerZoneStatus: v1beta1.LoadBalancerZoneStatus{
+					Ready:     true,
+					IPs:       []string{"51.15.0.1"},
+					PrivateIP: scw.StringPtr("172.16.0.2"),
+				},
+			},
+			private: true,
+			wantA:   []string{"172.16.0.2"},
+		},
+		{
+			name:     "no IP",
+			lbStatus: &v1beta1.LoadBalancerStatus{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domainAPI := fake.NewDomainAPI(testZone)
+			s := newTestService(domainAPI, tt.lbStatus)
+
+			if tt.private {
+				s.ScalewayCluster.Spec.ControlPlaneLoadBalancer = &v1beta1.LoadBalancerSpec{Private: scw.BoolPtr(true)}
+			}
+
+			err := s.Reconcile(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Reconcile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got := records(t, domainAPI, domain.RecordTypeA, DefaultTTL); !slices.Equal(got, tt.wantA) {
+				t.Errorf("A records = %v, want %v", got, tt.wantA)
+			}
+
+			if got := records(t, domainAPI, domain.RecordTypeAAAA, DefaultTTL); !slices.Equal(got, tt.wantAAAA) {
+				t.Errorf("AAAA records = %v, want %v", got, tt.wantAAAA)
+			}
+		})
+	}
+}
+
+func TestReconcileUpdate(t *testing.T) {
+	domainAPI := fake.NewDomainAPI(testZone)
+	s := newTestService(domainAPI, &v1beta1.LoadBalancerStatus{
+		LoadBalancerZoneStatus: v1beta1.LoadBalancerZoneStatus{
+			Ready: true,
+			IPs:   []string{"51.15.0.1", "2001:db8::1"},
+		},
+	})
+
+	if err := s.Reconcile(context.Background()); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	// The TTL is changed and the loadbalancer loses its IPv6.
+	s.ScalewayCluster.Spec.ControlPlaneDNS.TTL = scw.Uint32Ptr(600)
+	s.ScalewayCluster.Status.LoadBalancer.IPs = []string{"51.15.0.1"}
+
+	if err := s.Reconcile(context.Background()); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	if got := records(t, domainAPI, domain.RecordTypeA, 600); !slices.Equal(got, []string{"51.15.0.1"}) {
+		t.Errorf("A records = %v, want [51.15.0.1]", got)
+	}
+
+	if got := records(t, domainAPI, domain.RecordTypeAAAA, 600); len(got) != 0 {
+		t.Errorf("AAAA records = %v, want none", got)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name    string
+		zones   []string
+		wantErr bool
+	}{
+		{
+			name:  "records are deleted",
+			zones: []string{testZone},
+		},
+		{
+			name: "DNS zone was deleted",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			domainAPI := fake.NewDomainAPI(tt.zones...)
+			s := newTestService(domainAPI, &v1beta1.LoadBalancerStatus{
+				LoadBalancerZoneStatus: v1beta1.LoadBalancerZoneStatus{
+					Ready: true,
+					IPs:   []string{"51.15.0.1", "2001:db8::1"},
+				},
+			})
+
+			if len(tt.zones) > 0 {
+				if err := s.Reconcile(context.Background()); err != nil {
+					t.Fatalf("Reconcile() error = %v", err)
+				}
+			}
+
+			if err := s.Delete(context.Background()); (err != nil) != tt.wantErr {
+				t.Fatalf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(tt.zones) == 0 {
+				return
+			}
+
+			for _, recordType := range []domain.RecordType{domain.RecordTypeA, domain.RecordTypeAAAA} {
+				if got := records(t, domainAPI, recordType, DefaultTTL); len(got) != 0 {
+					t.Errorf("%s records = %v, want none", recordType, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package failuredomain
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	block "github.com/scaleway/scaleway-sdk-go/api/block/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Attributes of the failure domains that are discovered from the Scaleway API.
+const (
+	// InstanceTypesAttribute is a comma-separated list of the instance types
+	// that are available in the zone.
+	InstanceTypesAttribute = "instanceTypes"
+	// SBSAttribute is "true" if SBS volumes are available in the zone.
+	SBSAttribute = "sbs"
+	// GPUAttribute is "true" if GPU instances are available in the zone.
+	GPUAttribute = "gpu"
+)
+
+// refreshInterval is the interval after which the attributes of a zone are
+// discovered again from the Scaleway API.
+const refreshInterval = time.Hour
+
+// cache contains the attributes discovered for each zone. The attributes of a
+// zone do not depend on the cluster, they are shared by all the clusters.
+var cache = &attributesCache{entries: make(map[scw.Zone]cacheEntry)}
+
+type cacheEntry struct {
+	attributes map[string]string
+	expiresAt  time.Time
+}
+
+type attributesCache struct {
+	mu      sync.Mutex
+	entries map[scw.Zone]cacheEntry
+}
+
+// get returns the attributes of the zone if they were discovered less than
+// refreshInterval ago.
+func (c *attributesCache) get(zone scw.Zone) (map[string]string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[zone]
+	if !ok || time.Now().After(entry.expiresAt) {
+		return nil, false
+	}
+
+	return entry.attributes, true
+}
+
+func (c *attributesCache) set(zone scw.Zone, attributes map[string]string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[zone] = cacheEntry{
+		attributes: attributes,
+		expiresAt:  time.Now().Add(refreshInterval),
+	}
+}
+
+type Service struct {
+	*scope.Cluster
+}
+
+func NewService(clusterScope *scope.Cluster) *Service {
+	return &Service{clusterScope}
+}
+
+// Reconcile sets the failure domains in the status, with the attributes that
+// are discovered from the Scaleway API. If the attributes of a zone cannot be
+// discovered, the attributes that are already in the status are kept so that
+// an API error does not prevent the cluster from being reconciled.
+func (s *Service) Reconcile(ctx context.Context) error {
+	previous := s.ScalewayCluster.Status.FailureDomains
+	failureDomains := s.FailureDomains()
+
+	for name, fd := range failureDomains {
+		attributes, err := s.attributes(ctx, scw.Zone(name))
+		if err != nil {
+			log.FromContext(ctx).Error(err, "failed to discover failure domain attributes", "zone", name)
+
+			if p, ok := previous[name]; ok {
+				attributes = p.Attributes
+			}
+		}
+
+		// Attributes of the spec take precedence.
+		if len(attributes) > 0 {
+			attributes = maps.Clone(attributes)
+			maps.Copy(attributes, fd.Attributes)
+			fd.Attributes = attributes
+		}
+
+		failureDomains[name] = fd
+	}
+
+	s.ScalewayCluster.Status.FailureDomains = failureDomains
+
+	return nil
+}
+
+// attributes returns the attributes of the zone that are discovered from the
+// Scaleway API. They are cached for refreshInterval.
+func (s *Service) attributes(ctx context.Context, zone scw.Zone) (map[string]string, error) {
+	if attributes, ok := cache.get(zone); ok {
+		return attributes, nil
+	}
+
+	attributes, err := s.discoverAttributes(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.set(zone, attributes)
+
+	return attributes, nil
+}
+
+// discoverAttributes discovers the attributes of the zone from the Scaleway API.
+func (s *Service) discoverAttributes(ctx context.Context, zone scw.Zone) (map[string]string, error) {
+	serverTypes, err := s.ScalewayClient.FindAvailableServerTypes(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	names := maps.Keys(serverTypes)
+	slices.Sort(names)
+
+	gpu := false
+	for _, serverType := range serverTypes {
+		if serverType.Gpu != nil && *serverType.Gpu > 0 {
+			gpu = true
+			break
+		}
+	}
+
+	return map[string]string{
+		InstanceTypesAttribute: strings.Join(names, ","),
+		SBSAttribute:           strconv.FormatBool(slices.Contains((&block.API{}).Zones(), zone)),
+		GPUAttribute:           strconv.FormatBool(gpu),
+	}, nil
+}
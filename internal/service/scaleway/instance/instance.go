@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"text/template"
 
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/loadbalancer"
@@ -17,11 +18,13 @@ import (
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"golang.org/x/exp/slices"
 	"sigs.k8s.io/cluster-api/api/v1beta1"
-	"sigs.k8s.io/cluster-api/util"
 )
 
 var (
 	ErrPrivateIPNotFound = errors.New("private IP not found in IPAM")
+	// ErrServerDeleting is returned by Delete while the server of the machine
+	// is being deleted.
+	ErrServerDeleting = errors.New("server is being deleted")
 )
 
 var errMachineHasNoIP = errors.New("machine has no IP")
@@ -40,39 +43,202 @@ func isValidUUID(u string) bool {
 }
 
 // getOrCreateIP gets or creates a public IP for the instance. If no IP is needed
-// it returns nil.
+// it returns nil. The flexible IP of an existing server (e.g. a server that is
+// adopted) is reused, otherwise the created IP is attached to the server.
 func (s *Service) getOrCreateIP(ctx context.Context) (*instance.IP, error) {
 	if !s.NeedsPublicIP() {
 		return nil, nil
 	}
 
-	ip, err := s.ScalewayClient.FindIPByTags(ctx, s.Zone(), s.Tags())
+	ip, err := s.getIP(ctx)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return nil, err
 	}
 
 	if ip == nil {
-		ipResp, err := s.ScalewayClient.Instance.CreateIP(&instance.CreateIPRequest{
-			Type: instance.IPTypeRoutedIPv4,
+		server, err := s.getServer(ctx)
+		if err != nil && !errors.Is(err, client.ErrNoItemFound) {
+			return nil, err
+		}
+
+		if server != nil && server.PublicIP != nil && !server.PublicIP.Dynamic {
+			ipResp, err := s.ScalewayClient.Instance.GetIP(&instance.GetIPRequest{
+				Zone: server.Zone,
+				IP:   server.PublicIP.ID,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return nil, fmt.Errorf("failed to get Instance IP of server: %w", err)
+			}
+
+			ip = ipResp.IP
+		} else {
+			ipResp, err := s.ScalewayClient.Instance.CreateIP(&instance.CreateIPRequest{
+				Type: instance.IPTypeRoutedIPv4,
+				Zone: s.Zone(),
+				Tags: s.Tags(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to create Instance IP: %w", err)
+			}
+
+			ip = ipResp.IP
+		}
+	}
+
+	// The IP is only attached by CreateServer when the server is created.
+	if ip.Server == nil {
+		if err := s.attachIP(ctx, ip); err != nil {
+			return nil, err
+		}
+	}
+
+	s.ScalewayMachine.Status.PublicIPID = &ip.ID
+
+	return ip, nil
+}
+
+// attachIP attaches the IP to the existing server of the machine. Nothing is
+// done if the server does not exist yet.
+func (s *Service) attachIP(ctx context.Context, ip *instance.IP) error {
+	server, err := s.getServer(ctx)
+	if err != nil {
+		if errors.Is(err, client.ErrNoItemFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	ipResp, err := s.ScalewayClient.Instance.UpdateIP(&instance.UpdateIPRequest{
+		Zone:   server.Zone,
+		IP:     ip.ID,
+		Server: &instance.NullableStringValue{Value: server.ID},
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to attach Instance IP to server: %w", err)
+	}
+
+	*ip = *ipResp.IP
+
+	return nil
+}
+
+// getIP returns the public IP of the instance. It is retrieved by its ID if it
+// is known in the status, otherwise it is searched by tags. It returns
+// client.ErrNoItemFound if the IP does not exist.
+func (s *Service) getIP(ctx context.Context) (*instance.IP, error) {
+	if s.ScalewayMachine.Status.PublicIPID != nil {
+		ipResp, err := s.ScalewayClient.Instance.GetIP(&instance.GetIPRequest{
 			Zone: s.Zone(),
-			Tags: s.Tags(),
-		})
+			IP:   *s.ScalewayMachine.Status.PublicIPID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return ipResp.IP, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ScalewayClient.FindIPByTags(ctx, s.Zone(), s.Tags())
+}
+
+// getServer returns the server of the machine. It is retrieved by its ID if it
+// is known in the status or in the provider ID, otherwise it is searched by
+// name. It returns client.ErrNoItemFound if the server does not exist.
+func (s *Service) getServer(ctx context.Context) (*instance.Server, error) {
+	serverID := s.ScalewayMachine.Status.ServerID
+
+	if serverID == nil && s.ScalewayMachine.Spec.ProviderID != nil {
+		_, id, err := infrastructurev1beta1.ParseProviderID(*s.ScalewayMachine.Spec.ProviderID)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create Instance IP: %w", err)
+			return nil, err
 		}
 
-		ip = ipResp.IP
+		serverID = &id
 	}
 
-	return ip, nil
+	if serverID != nil {
+		serverResp, err := s.ScalewayClient.Instance.GetServer(&instance.GetServerRequest{
+			Zone:     s.Zone(),
+			ServerID: *serverID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return serverResp.Server, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	// The server referenced by a provider ID does not have the name of the
+	// machine if it was adopted.
+	if s.ScalewayMachine.Spec.ProviderID != nil {
+		return nil, client.ErrNoItemFound
+	}
+
+	return s.ScalewayClient.FindInstanceByName(ctx, s.Zone(), s.Name())
+}
+
+// getSecurityGroupID returns the ID of the security group with the provided
+// name (as specified in the ScalewayCluster object) in the zone of the machine.
+func (s *Service) getSecurityGroupID(ctx context.Context, name string) (string, error) {
+	if sgID, ok := s.SecurityGroupID(name, s.Zone()); ok {
+		return sgID, nil
+	}
+
+	sgName := s.SecurityGroupName(name)
+	sg, err := s.ScalewayClient.FindSecurityGroupByName(ctx, s.Zone(), sgName)
+	if err != nil {
+		return "", fmt.Errorf("failed to find security group %q: %w", sgName, err)
+	}
+
+	return sg.ID, nil
+}
+
+// setStatusServer sets the IDs of the server and its volumes in the status.
+func (s *Service) setStatusServer(server *instance.Server) {
+	s.ScalewayMachine.Status.ServerID = &server.ID
+
+	keys := make([]string, 0, len(server.Volumes))
+	for key := range server.Volumes {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	s.ScalewayMachine.Status.VolumeIDs = make([]string, 0, len(keys))
+	for _, key := range keys {
+		s.ScalewayMachine.Status.VolumeIDs = append(s.ScalewayMachine.Status.VolumeIDs, server.Volumes[key].ID)
+	}
 }
 
 func (s *Service) getOrCreateServer(ctx context.Context, ip *instance.IP) (*instance.Server, error) {
-	server, err := s.ScalewayClient.FindInstanceByName(ctx, s.Zone(), s.Name())
+	server, err := s.getServer(ctx)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return nil, err
 	}
 
+	// Never create a server if a provider ID is set: the server was either
+	// deleted or the provider ID references a server that does not exist.
+	if server == nil && s.ScalewayMachine.Spec.ProviderID != nil {
+		return nil, fmt.Errorf("server with provider ID %q not found", *s.ScalewayMachine.Spec.ProviderID)
+	}
+
+	if server != nil && s.needsAdoption(server) {
+		if err := s.adoptServer(ctx, server); err != nil {
+			return nil, fmt.Errorf("failed to adopt server %q: %w", server.ID, err)
+		}
+	}
+
+	if server != nil {
+		if err := s.ensureServerTags(ctx, server); err != nil {
+			return nil, err
+		}
+	}
+
 	if server == nil {
 		rootSize := 20 * scw.GB
 		if s.ScalewayMachine.Spec.RootVolumeSize != nil {
@@ -96,13 +262,12 @@ func (s *Service) getOrCreateServer(ctx context.Context, ip *instance.IP) (*inst
 		// Find security group ID if needed.
 		var sgID *string
 		if s.ScalewayMachine.Spec.SecurityGroupName != nil {
-			sgName := s.SecurityGroupName(*s.ScalewayMachine.Spec.SecurityGroupName)
-			sg, err := s.ScalewayClient.FindSecurityGroupByName(ctx, s.Zone(), sgName)
+			id, err := s.getSecurityGroupID(ctx, *s.ScalewayMachine.Spec.SecurityGroupName)
 			if err != nil {
-				return nil, fmt.Errorf("failed to find security group %q: %w", sgName, err)
+				return nil, err
 			}
 
-			sgID = &sg.ID
+			sgID = &id
 		}
 
 		volType, err := s.ScalewayMachine.Spec.ScalewayRootVolumeType()
@@ -118,6 +283,7 @@ func (s *Service) getOrCreateServer(ctx context.Context, ip *instance.IP) (*inst
 			RoutedIPEnabled:   scw.BoolPtr(true),
 			Image:             imageID,
 			SecurityGroup:     sgID,
+			Tags:              s.Tags(),
 			Volumes: map[string]*instance.VolumeServerTemplate{
 				"0": {
 					Size:       scw.SizePtr(rootSize),
@@ -139,9 +305,126 @@ func (s *Service) getOrCreateServer(ctx context.Context, ip *instance.IP) (*inst
 		server = serverResp.Server
 	}
 
+	s.setStatusServer(server)
+
 	return server, nil
 }
 
+// needsAdoption returns true if the server was not created by the provider and
+// was not adopted yet.
+func (s *Service) needsAdoption(server *instance.Server) bool {
+	if server.Name == s.Name() {
+		return false
+	}
+
+	for _, tag := range s.Tags() {
+		if !slices.Contains(server.Tags, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// adoptServer validates that an existing server matches the spec of the
+// ScalewayMachine and tags it so that it can be managed like any other server
+// created by the provider.
+func (s *Service) adoptServer(ctx context.Context, server *instance.Server) error {
+	if s.Machine.Machine.Spec.FailureDomain != nil && *s.Machine.Machine.Spec.FailureDomain != server.Zone.String() {
+		return fmt.Errorf("server is in zone %s but machine failure domain is %s", server.Zone, *s.Machine.Machine.Spec.FailureDomain)
+	}
+
+	if server.CommercialType != s.ScalewayMachine.Spec.Type {
+		return fmt.Errorf("server type %s does not match expected type %s", server.CommercialType, s.ScalewayMachine.Spec.Type)
+	}
+
+	if err := s.validateServerImage(ctx, server); err != nil {
+		return err
+	}
+
+	tags, _ := client.MergeTags(server.Tags, s.Tags())
+
+	req := &instance.UpdateServerRequest{
+		Zone:     server.Zone,
+		ServerID: server.ID,
+		Tags:     &tags,
+	}
+
+	if s.ScalewayMachine.Spec.SecurityGroupName != nil {
+		sgID, err := s.getSecurityGroupID(ctx, *s.ScalewayMachine.Spec.SecurityGroupName)
+		if err != nil {
+			return err
+		}
+
+		if server.SecurityGroup == nil || server.SecurityGroup.ID != sgID {
+			req.SecurityGroup = &instance.SecurityGroupTemplate{ID: sgID}
+		}
+	}
+
+	if _, err := s.ScalewayClient.Instance.UpdateServer(req, scw.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to update server: %w", err)
+	}
+
+	server.Tags = tags
+
+	return nil
+}
+
+// ensureServerTags adds the tags of the machine to the server. Servers created
+// by previous versions of the provider have no tags, they are needed to
+// restore the status of the machine.
+func (s *Service) ensureServerTags(ctx context.Context, server *instance.Server) error {
+	tags, ok := client.MergeTags(server.Tags, s.Tags())
+	if !ok {
+		return nil
+	}
+
+	if _, err := s.ScalewayClient.Instance.UpdateServer(&instance.UpdateServerRequest{
+		Zone:     server.Zone,
+		ServerID: server.ID,
+		Tags:     &tags,
+	}, scw.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to tag server: %w", err)
+	}
+
+	server.Tags = tags
+
+	return nil
+}
+
+// validateServerImage returns an error if the image of the server does not
+// match the image of the ScalewayMachine. When the image is a label, all the
+// versions of the image with this label are accepted.
+func (s *Service) validateServerImage(ctx context.Context, server *instance.Server) error {
+	if server.Image == nil {
+		return errors.New("server has no image")
+	}
+
+	if isValidUUID(s.ScalewayMachine.Spec.Image) {
+		if server.Image.ID != s.ScalewayMachine.Spec.Image {
+			return fmt.Errorf("server image %s does not match expected image %s", server.Image.ID, s.ScalewayMachine.Spec.Image)
+		}
+
+		return nil
+	}
+
+	images, err := s.ScalewayClient.Marketplace.ListLocalImages(&marketplace.ListLocalImagesRequest{
+		ImageLabel: &s.ScalewayMachine.Spec.Image,
+		Zone:       &server.Zone,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to list images with label %q: %w", s.ScalewayMachine.Spec.Image, err)
+	}
+
+	if !slices.ContainsFunc(images.LocalImages, func(image *marketplace.LocalImage) bool {
+		return image.ID == server.Image.ID
+	}) {
+		return fmt.Errorf("server image %s does not match expected image label %s", server.Image.ID, s.ScalewayMachine.Spec.Image)
+	}
+
+	return nil
+}
+
 func (s *Service) getOrCreatePrivateNIC(ctx context.Context, server *instance.Server) (*instance.PrivateNIC, error) {
 	if !s.HasPrivateNetwork() {
 		return nil, nil
@@ -152,17 +435,29 @@ func (s *Service) getOrCreatePrivateNIC(ctx context.Context, server *instance.Se
 		return nil, err
 	}
 
-	pnic, err := s.ScalewayClient.FindPrivateNICByPNID(ctx, server, pnID)
+	pnic, err := s.getPrivateNIC(ctx, server, pnID)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return nil, err
 	}
 
 	if pnic == nil {
-		p, err := s.ScalewayClient.Instance.CreatePrivateNIC(&instance.CreatePrivateNICRequest{
+		req := &instance.CreatePrivateNICRequest{
 			Zone:             s.Zone(),
 			ServerID:         server.ID,
 			PrivateNetworkID: pnID,
-		}, scw.WithContext(ctx))
+			Tags:             s.Tags(),
+		}
+
+		ipID, err := s.getOrReservePrivateIP(ctx, pnID)
+		if err != nil {
+			return nil, err
+		}
+
+		if ipID != nil {
+			req.IPIDs = []string{*ipID}
+		}
+
+		p, err := s.ScalewayClient.Instance.CreatePrivateNIC(req, scw.WithContext(ctx))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create private NIC: %w", err)
 		}
@@ -170,9 +465,34 @@ func (s *Service) getOrCreatePrivateNIC(ctx context.Context, server *instance.Se
 		pnic = p.PrivateNic
 	}
 
+	s.ScalewayMachine.Status.PrivateNICID = &pnic.ID
+
 	return pnic, nil
 }
 
+// getPrivateNIC returns the private NIC of the server in the Private Network.
+// It is retrieved by its ID if it is known in the status, otherwise it is
+// searched by Private Network ID. It returns client.ErrNoItemFound if the
+// private NIC does not exist.
+func (s *Service) getPrivateNIC(ctx context.Context, server *instance.Server, pnID string) (*instance.PrivateNIC, error) {
+	if s.ScalewayMachine.Status.PrivateNICID != nil {
+		pnicResp, err := s.ScalewayClient.Instance.GetPrivateNIC(&instance.GetPrivateNICRequest{
+			Zone:         server.Zone,
+			ServerID:     server.ID,
+			PrivateNicID: *s.ScalewayMachine.Status.PrivateNICID,
+		}, scw.WithContext(ctx))
+		if err == nil && pnicResp.PrivateNic.PrivateNetworkID == pnID {
+			return pnicResp.PrivateNic, nil
+		}
+
+		if err != nil && !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ScalewayClient.FindPrivateNICByPNID(ctx, server, pnID)
+}
+
 type machineIPs struct {
 	Internal *string
 	External *string
@@ -220,6 +540,9 @@ func (s *Service) getMachineIPs(ctx context.Context, server *instance.Server, pn
 type bootstrapValues struct {
 	NodeIP     string
 	ProviderID string
+	// LoadBalancerPrivateIP is the IP of the control-plane loadbalancer in the
+	// Private Network. It is empty if the cluster has no Private Network.
+	LoadBalancerPrivateIP string
 }
 
 func patchBootstrapData(data []byte, values *bootstrapValues) ([]byte, error) {
@@ -255,9 +578,15 @@ func (s *Service) ensureCloudInit(ctx context.Context, server *instance.Server,
 			return err
 		}
 
+		var lbPrivateIP string
+		if status := s.ScalewayCluster.Status.LoadBalancer; status != nil && status.PrivateIP != nil {
+			lbPrivateIP = *status.PrivateIP
+		}
+
 		bootstrapData, err = patchBootstrapData(bootstrapData, &bootstrapValues{
-			NodeIP:     machineIPs.NodeIP(),
-			ProviderID: s.ProviderID(server.ID),
+			NodeIP:                machineIPs.NodeIP(),
+			ProviderID:            s.ProviderID(server.ID),
+			LoadBalancerPrivateIP: lbPrivateIP,
 		})
 		if err != nil {
 			return err
@@ -292,100 +621,66 @@ func (s *Service) ensureServerStarted(ctx context.Context, server *instance.Serv
 	return nil
 }
 
-func (s *Service) ensureLoadBalancerACL(ctx context.Context, publicIP *string) error {
-	frontend, err := s.ScalewayClient.FindLoadBalancerFrontendByNames(
+// getControlPlaneFrontend returns the control-plane frontend of the cluster
+// loadbalancer. It is retrieved by its ID if it is known in the ScalewayCluster
+// status, otherwise it is searched by name.
+func (s *Service) getControlPlaneFrontend(ctx context.Context) (*lb.Frontend, error) {
+	if status := s.ScalewayCluster.Status.LoadBalancer; status != nil && status.FrontendID != nil {
+		frontend, err := s.ScalewayClient.LoadBalancer.GetFrontend(&lb.ZonedAPIGetFrontendRequest{
+			Zone:       s.Cluster.LoadBalancerZone(),
+			FrontendID: *status.FrontendID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return frontend, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ScalewayClient.FindLoadBalancerFrontendByNames(
 		ctx,
 		s.Cluster.LoadBalancerZone(),
 		s.Cluster.Name(),
 		loadbalancer.ControlPlaneFrontendName,
 	)
-	if err != nil {
-		return fmt.Errorf("failed to find load balancer frontend: %w", err)
-	}
-
-	acl, err := s.ScalewayClient.FindLoadBalancerACLByName(ctx, s.LoadBalancerZone(), frontend.ID, s.Name())
-	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
-		return fmt.Errorf("failed to find load balancer ACL: %w", err)
-	}
-
-	if publicIP == nil {
-		if acl != nil {
-			if err := s.ScalewayClient.LoadBalancer.DeleteACL(&lb.ZonedAPIDeleteACLRequest{
-				Zone:  s.LoadBalancerZone(),
-				ACLID: acl.ID,
-			}, scw.WithContext(ctx)); err != nil {
-				return fmt.Errorf("failed to delete load balancer ACL: %w", err)
-			}
-		}
+}
 
+// deleteLegacyLoadBalancerACL deletes the ACL that was created for this machine
+// on the control-plane frontend by previous versions of the provider. The
+// public IPs of the nodes are now allowed by the nodes ACLs of the cluster.
+func (s *Service) deleteLegacyLoadBalancerACL(ctx context.Context) error {
+	if !s.HasLoadBalancer() {
 		return nil
 	}
 
-	match := scw.StringSlicePtr([]string{*publicIP})
-
-	if acl == nil {
-		_, err := s.ScalewayClient.LoadBalancer.CreateACL(&lb.ZonedAPICreateACLRequest{
-			Zone:       s.LoadBalancerZone(),
-			FrontendID: frontend.ID,
-			Name:       s.Name(),
-			Action:     &lb.ACLAction{Type: lb.ACLActionTypeAllow},
-			Match:      &lb.ACLMatch{IPSubnet: match},
-			Index:      3,
-		}, scw.WithContext(ctx))
-
-		return err
+	// The frontend of an externally managed cluster is only used if its ID
+	// is provided in the status.
+	if s.Cluster.IsExternallyManaged() &&
+		(s.ScalewayCluster.Status.LoadBalancer == nil || s.ScalewayCluster.Status.LoadBalancer.FrontendID == nil) {
+		return nil
 	}
 
-	if acl.Match == nil || !slices.Equal(match, acl.Match.IPSubnet) {
-		_, err := s.ScalewayClient.LoadBalancer.UpdateACL(&lb.ZonedAPIUpdateACLRequest{
-			Zone:   s.LoadBalancerZone(),
-			ACLID:  acl.ID,
-			Name:   s.Name(),
-			Action: &lb.ACLAction{Type: lb.ACLActionTypeAllow},
-			Match:  &lb.ACLMatch{IPSubnet: match},
-			Index:  3,
-		}, scw.WithContext(ctx))
-
-		return err
+	frontend, err := s.getControlPlaneFrontend(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find load balancer frontend: %w", err)
 	}
 
-	return nil
-}
+	acl, err := s.ScalewayClient.FindLoadBalancerACLByName(ctx, s.LoadBalancerZone(), frontend.ID, s.Name())
+	if err != nil {
+		if errors.Is(err, client.ErrNoItemFound) {
+			return nil
+		}
 
-func (s *Service) ensureControlPlaneLoadBalancer(ctx context.Context, server *instance.Server, pnic *instance.PrivateNIC, ips *machineIPs, deletion bool) error {
-	if !util.IsControlPlaneMachine(s.Machine.Machine) {
-		return nil
+		return fmt.Errorf("failed to find load balancer ACL: %w", err)
 	}
 
-	backend, err := s.ScalewayClient.FindLoadBalancerBackendByNames(
-		ctx,
-		s.Cluster.LoadBalancerZone(),
-		s.Cluster.Name(),
-		loadbalancer.ControlPlaneBackendName,
-	)
-	if err != nil {
-		return fmt.Errorf("failed to find load balancer backend: %w", err)
-	}
-
-	switch {
-	case deletion && slices.Contains(backend.Pool, ips.NodeIP()):
-		if slices.Contains(backend.Pool, ips.NodeIP()) {
-			if _, err := s.ScalewayClient.LoadBalancer.RemoveBackendServers(&lb.ZonedAPIRemoveBackendServersRequest{
-				Zone:      s.Cluster.LoadBalancerZone(),
-				BackendID: backend.ID,
-				ServerIP:  []string{ips.NodeIP()},
-			}); err != nil {
-				return err
-			}
-		}
-	case !deletion && !slices.Contains(backend.Pool, ips.NodeIP()):
-		if _, err := s.ScalewayClient.LoadBalancer.AddBackendServers(&lb.ZonedAPIAddBackendServersRequest{
-			Zone:      s.Cluster.LoadBalancerZone(),
-			BackendID: backend.ID,
-			ServerIP:  []string{ips.NodeIP()},
-		}); err != nil {
-			return err
-		}
+	if err := s.ScalewayClient.LoadBalancer.DeleteACL(&lb.ZonedAPIDeleteACLRequest{
+		Zone:  s.LoadBalancerZone(),
+		ACLID: acl.ID,
+	}, scw.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to delete load balancer ACL: %w", err)
 	}
 
 	return nil
@@ -412,14 +707,6 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return err
 	}
 
-	if err := s.ensureControlPlaneLoadBalancer(ctx, server, pnic, machineIPs, false); err != nil {
-		return err
-	}
-
-	if err := s.ensureLoadBalancerACL(ctx, machineIPs.External); err != nil {
-		return err
-	}
-
 	if err := s.ensureCloudInit(ctx, server, machineIPs); err != nil {
 		return err
 	}
@@ -446,51 +733,78 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		})
 	}
 
+	// IPv6 addresses are listed after the IPv4 addresses so that they are
+	// never used as node IP.
+	for _, ip := range server.PublicIPs {
+		if ip.Family == instance.ServerIPIPFamilyInet6 {
+			s.ScalewayMachine.Status.Addresses = append(s.ScalewayMachine.Status.Addresses, v1beta1.MachineAddress{
+				Type:    v1beta1.MachineExternalIP,
+				Address: ip.Address.String(),
+			})
+		}
+	}
+
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context) error {
-	server, err := s.ScalewayClient.FindInstanceByName(ctx, s.Zone(), s.Name())
+// Restore restores the server and IP IDs in the status from the provider ID of
+// the machine. This is needed when the status was lost, for example after the
+// ScalewayMachine was moved to another management cluster.
+func (s *Service) Restore(ctx context.Context) error {
+	if !s.NeedsRestore() {
+		return nil
+	}
+
+	zone, serverID, err := infrastructurev1beta1.ParseProviderID(*s.ScalewayMachine.Spec.ProviderID)
 	if err != nil {
-		if errors.Is(err, client.ErrNoItemFound) {
+		return err
+	}
+
+	serverResp, err := s.ScalewayClient.Instance.GetServer(&instance.GetServerRequest{
+		Zone:     zone,
+		ServerID: serverID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		if client.IsNotFoundError(err) {
 			return nil
 		}
 
 		return err
 	}
 
-	// Set publicIP to nil to force deletion.
-	if err := s.ensureLoadBalancerACL(ctx, nil); err != nil && !errors.Is(err, client.ErrNoItemFound) {
-		return err
-	}
+	server := serverResp.Server
 
-	// Remove this control-plane from the loadbalancer.
-	if util.IsControlPlaneMachine(s.Machine.Machine) {
-		var pnic *instance.PrivateNIC
+	s.setStatusServer(server)
 
-		if s.HasPrivateNetwork() {
-			pnID, err := s.PrivateNetworkID()
-			if err != nil {
-				return err
-			}
+	if server.PublicIP != nil && !server.PublicIP.Dynamic {
+		s.ScalewayMachine.Status.PublicIPID = &server.PublicIP.ID
+	}
 
-			pnic, err = s.ScalewayClient.FindPrivateNICByPNID(ctx, server, pnID)
-			if err != nil {
-				return fmt.Errorf("failed to find PrivateNIC by PNID: %w", err)
-			}
-		}
+	return nil
+}
 
-		machineIPs, err := s.getMachineIPs(ctx, server, pnic)
-		if err != nil && !errors.Is(err, errMachineHasNoIP) {
-			return fmt.Errorf("failed to get machine IPs for control-plane machine: %w", err)
+// Delete deletes the server of the machine and its resources. ErrServerDeleting
+// is returned until the server is gone, so that the private IP reserved for
+// the machine is only released once its private NIC is deleted.
+func (s *Service) Delete(ctx context.Context) error {
+	server, err := s.getServer(ctx)
+	if err != nil {
+		// The private NIC is deleted with the server, the reserved private IP
+		// can now be released.
+		if errors.Is(err, client.ErrNoItemFound) {
+			return s.releasePrivateIP(ctx)
 		}
 
-		if machineIPs != nil {
-			err = s.ensureControlPlaneLoadBalancer(ctx, server, pnic, machineIPs, true)
-			if err != nil && !errors.Is(err, client.ErrNoItemFound) {
-				return err
-			}
-		}
+		return err
+	}
+
+	// The server is already being terminated.
+	if server.State == instance.ServerStateStopping {
+		return ErrServerDeleting
+	}
+
+	if err := s.deleteLegacyLoadBalancerACL(ctx); err != nil && !errors.Is(err, client.ErrNoItemFound) {
+		return err
 	}
 
 	// Delete flexible IP.
@@ -498,7 +812,7 @@ func (s *Service) Delete(ctx context.Context) error {
 		if err := s.ScalewayClient.Instance.DeleteIP(&instance.DeleteIPRequest{
 			Zone: server.Zone,
 			IP:   server.PublicIP.ID,
-		}, scw.WithContext(ctx)); err != nil {
+		}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
 			return err
 		}
 	}
@@ -543,7 +857,7 @@ func (s *Service) Delete(ctx context.Context) error {
 			return fmt.Errorf("failed to delete instance: %w", err)
 		}
 
-		return nil
+		return ErrServerDeleting
 	}
 
 	if _, err := s.ScalewayClient.Instance.ServerAction(&instance.ServerActionRequest{
@@ -554,5 +868,5 @@ func (s *Service) Delete(ctx context.Context) error {
 		return fmt.Errorf("failed to terminate server: %w", err)
 	}
 
-	return nil
+	return ErrServerDeleting
 }
@@ -0,0 +1,266 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	serversPath = "/instance/v1/zones/fr-par-1/servers"
+	knownID     = "22222222-2222-2222-2222-222222222222"
+)
+
+func TestGetServer(t *testing.T) {
+	// A server with the name of the machine that was not created by the
+	// provider.
+	lookalike := &instance.Server{ID: "33333333-3333-3333-3333-333333333333", Name: "caps-test", Zone: scw.ZoneFrPar1}
+
+	tests := []struct {
+		name       string
+		statusID   *string
+		known      bool
+		missing    bool
+		wantID     string
+		wantErr    error
+		wantListed bool
+	}{
+		{
+			name:     "known ID",
+			statusID: scw.StringPtr(knownID),
+			known:    true,
+			wantID:   knownID,
+		},
+		{
+			name:       "known ID of a deleted server",
+			statusID:   scw.StringPtr(knownID),
+			wantID:     lookalike.ID,
+			wantListed: true,
+		},
+		{
+			name:       "unknown ID",
+			wantID:     lookalike.ID,
+			wantListed: true,
+		},
+		{
+			name:       "no server",
+			missing:    true,
+			wantErr:    client.ErrNoItemFound,
+			wantListed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &instance.ListServersResponse{
+				Servers:    []*instance.Server{lookalike},
+				TotalCount: 1,
+			}
+			if tt.missing {
+				list = &instance.ListServersResponse{}
+			}
+
+			api := fake.NewAPI()
+			api.JSON("GET "+serversPath, list)
+
+			if tt.known {
+				api.JSON("GET "+serversPath+"/"+knownID, &instance.GetServerResponse{
+					Server: &instance.Server{ID: knownID, Name: "caps-test", Zone: scw.ZoneFrPar1},
+				})
+			}
+
+			s := NewService(&scope.Machine{
+				Cluster: scope.Cluster{
+					ScalewayClient: fake.NewClient(t, api),
+					ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+						Spec: infrastructurev1beta1.ScalewayClusterSpec{Region: "fr-par"},
+					},
+				},
+				ScalewayMachine: &infrastructurev1beta1.ScalewayMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Status:     infrastructurev1beta1.ScalewayMachineStatus{ServerID: tt.statusID},
+				},
+				Machine: &v1beta1.Machine{Spec: v1beta1.MachineSpec{FailureDomain: scw.StringPtr("fr-par-1")}},
+			})
+
+			server, err := s.getServer(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("getServer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if server != nil && server.ID != tt.wantID {
+				t.Errorf("getServer() = %s, want %s", server.ID, tt.wantID)
+			}
+
+			if listed := api.Called("GET " + serversPath); listed != tt.wantListed {
+				t.Errorf("servers listed = %v, want %v", listed, tt.wantListed)
+			}
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	tests := []struct {
+		name       string
+		providerID *string
+		exists     bool
+		wantID     *string
+	}{
+		{
+			name: "new machine",
+		},
+		{
+			name:       "moved machine",
+			providerID: scw.StringPtr("scaleway://instance/fr-par-1/" + knownID),
+			exists:     true,
+			wantID:     scw.StringPtr(knownID),
+		},
+		{
+			name:       "moved machine with a deleted server",
+			providerID: scw.StringPtr("scaleway://instance/fr-par-1/" + knownID),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.NewAPI()
+			if tt.exists {
+				api.JSON("GET "+serversPath+"/"+knownID, &instance.GetServerResponse{
+					Server: &instance.Server{ID: knownID, Name: "caps-test", Zone: scw.ZoneFrPar1},
+				})
+			}
+
+			s := NewService(&scope.Machine{
+				Cluster: scope.Cluster{
+					ScalewayClient: fake.NewClient(t, api),
+					ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+						Spec: infrastructurev1beta1.ScalewayClusterSpec{Region: "fr-par"},
+					},
+				},
+				ScalewayMachine: &infrastructurev1beta1.ScalewayMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       infrastructurev1beta1.ScalewayMachineSpec{ProviderID: tt.providerID},
+				},
+				Machine: &v1beta1.Machine{},
+			})
+
+			if err := s.Restore(context.Background()); err != nil {
+				t.Fatalf("Restore() error = %v", err)
+			}
+
+			if got := s.ScalewayMachine.Status.ServerID; !reflect.DeepEqual(got, tt.wantID) {
+				t.Errorf("Restore() ServerID = %v, want %v", got, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestGetAdoptedServer(t *testing.T) {
+	const adoptedPath = "/instance/v1/zones/fr-par-2/servers"
+
+	tests := []struct {
+		name    string
+		exists  bool
+		wantErr error
+	}{
+		{
+			name:   "existing server",
+			exists: true,
+		},
+		{
+			name:    "deleted server",
+			wantErr: client.ErrNoItemFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.NewAPI()
+			api.JSON("GET "+adoptedPath, &instance.ListServersResponse{})
+
+			if tt.exists {
+				// The adopted server does not have the name of the machine.
+				api.JSON("GET "+adoptedPath+"/"+knownID, &instance.GetServerResponse{
+					Server: &instance.Server{ID: knownID, Name: "existing", Zone: scw.ZoneFrPar2},
+				})
+			}
+
+			s := NewService(&scope.Machine{
+				Cluster: scope.Cluster{
+					ScalewayClient: fake.NewClient(t, api),
+					ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+						Spec: infrastructurev1beta1.ScalewayClusterSpec{Region: "fr-par"},
+					},
+				},
+				ScalewayMachine: &infrastructurev1beta1.ScalewayMachine{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrastructurev1beta1.ScalewayMachineSpec{
+						ProviderID: scw.StringPtr("scaleway://instance/fr-par-2/" + knownID),
+					},
+				},
+				Machine: &v1beta1.Machine{},
+			})
+
+			server, err := s.getServer(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("getServer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if server != nil && server.ID != knownID {
+				t.Errorf("getServer() = %s, want %s", server.ID, knownID)
+			}
+
+			if api.Called("GET " + adoptedPath) {
+				t.Errorf("servers were listed, the adopted server must only be retrieved by ID")
+			}
+		})
+	}
+}
+
+func TestPatchBootstrapData(t *testing.T) {
+	values := &bootstrapValues{
+		NodeIP:                "172.16.0.2",
+		ProviderID:            "scaleway://instance/fr-par-1/" + knownID,
+		LoadBalancerPrivateIP: "172.16.0.10",
+	}
+
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{
+			name: "node IP and provider ID",
+			data: "--node-ip=[[[ .NodeIP ]]] --provider-id=[[[ .ProviderID ]]]",
+			want: "--node-ip=172.16.0.2 --provider-id=scaleway://instance/fr-par-1/" + knownID,
+		},
+		{
+			name: "loadbalancer private IP",
+			data: "server: https://[[[ .LoadBalancerPrivateIP ]]]:6443",
+			want: "server: https://172.16.0.10:6443",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := patchBootstrapData([]byte(tt.data), values)
+			if err != nil {
+				t.Fatalf("patchBootstrapData() error = %v", err)
+			}
+
+			if string(got) != tt.want {
+				t.Errorf("patchBootstrapData() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
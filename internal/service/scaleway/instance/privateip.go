@@ -0,0 +1,132 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"sigs.k8s.io/cluster-api/util"
+)
+
+// staticPrivateIPs returns the static private IPs that the machine can reserve:
+// the private IP of the ScalewayMachine, or the control-plane IPs of the
+// cluster for control-plane machines. It returns nil if the private IP of the
+// machine is assigned by DHCP.
+func (s *Service) staticPrivateIPs() []string {
+	if s.ScalewayMachine.Spec.PrivateIP != nil {
+		return []string{*s.ScalewayMachine.Spec.PrivateIP}
+	}
+
+	if util.IsControlPlaneMachine(s.Machine.Machine) {
+		return s.ScalewayCluster.Spec.Network.PrivateNetwork.ControlPlaneIPs
+	}
+
+	return nil
+}
+
+// getPrivateIP returns the IP that was reserved in IPAM for the machine. It is
+// retrieved by its ID if it is known in the status, otherwise it is searched
+// by tags. It returns client.ErrNoItemFound if the IP does not exist.
+func (s *Service) getPrivateIP(ctx context.Context, pnID string) (*ipam.IP, error) {
+	if s.ScalewayMachine.Status.PrivateIPID != nil {
+		ip, err := s.ScalewayClient.IPAM.GetIP(&ipam.GetIPRequest{
+			Region: s.Cluster.Region(),
+			IPID:   *s.ScalewayMachine.Status.PrivateIPID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return ip, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ScalewayClient.FindPrivateIPByTags(ctx, s.Cluster.Region(), pnID, s.Tags())
+}
+
+// getOrReservePrivateIP reserves a static private IP for the machine in IPAM
+// and returns its ID. The IPs are tried in order until one of them can be
+// reserved. It returns nil if the private IP of the machine is assigned by
+// DHCP.
+func (s *Service) getOrReservePrivateIP(ctx context.Context, pnID string) (*string, error) {
+	candidates := s.staticPrivateIPs()
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	ip, err := s.getPrivateIP(ctx, pnID)
+	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
+		return nil, err
+	}
+
+	if ip == nil {
+		var errs []error
+
+		for _, candidate := range candidates {
+			address := net.ParseIP(candidate)
+			if address == nil {
+				return nil, fmt.Errorf("invalid private IP %q", candidate)
+			}
+
+			ip, err = s.ScalewayClient.IPAM.BookIP(&ipam.BookIPRequest{
+				Region:    s.Cluster.Region(),
+				ProjectID: s.ScalewayClient.ProjectID,
+				Source:    &ipam.Source{PrivateNetworkID: &pnID},
+				Address:   &address,
+				Tags:      s.Tags(),
+			}, scw.WithContext(ctx))
+			if err == nil {
+				break
+			}
+
+			errs = append(errs, fmt.Errorf("%s: %w", candidate, err))
+		}
+
+		if ip == nil {
+			return nil, fmt.Errorf("failed to reserve a private IP: %w", errors.Join(errs...))
+		}
+	}
+
+	s.ScalewayMachine.Status.PrivateIPID = &ip.ID
+
+	return &ip.ID, nil
+}
+
+// releasePrivateIP releases the IP that was reserved in IPAM for the machine.
+// It must be called once the private NIC is deleted.
+func (s *Service) releasePrivateIP(ctx context.Context) error {
+	if !s.HasPrivateNetwork() || len(s.staticPrivateIPs()) == 0 {
+		return nil
+	}
+
+	pnID, err := s.PrivateNetworkID()
+	if err != nil {
+		return err
+	}
+
+	ip, err := s.getPrivateIP(ctx, pnID)
+	if err != nil {
+		if errors.Is(err, client.ErrNoItemFound) {
+			s.ScalewayMachine.Status.PrivateIPID = nil
+			return nil
+		}
+
+		return err
+	}
+
+	if err := s.ScalewayClient.IPAM.ReleaseIP(&ipam.ReleaseIPRequest{
+		Region: s.Cluster.Region(),
+		IPID:   ip.ID,
+	}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+		return fmt.Errorf("failed to release private IP: %w", err)
+	}
+
+	s.ScalewayMachine.Status.PrivateIPID = nil
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const testPNID = "22222222-2222-2222-2222-222222222222"
+
+// fakeIPAM is an in-memory IPAM API. Like the real API, ListIPs returns the
+// IPs that have at least one of the requested tags.
+type fakeIPAM struct {
+	mu  sync.Mutex
+	ips map[string]*ipam.IP
+}
+
+func (f *fakeIPAM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	id, isItem := strings.CutPrefix(r.URL.Path, "/ipam/v1/regions/fr-par/ips/")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/ipam/v1/regions/fr-par/ips":
+		tags := r.URL.Query()["tags"]
+		resp := &ipam.ListIPsResponse{}
+
+		for _, ip := range f.ips {
+			if len(tags) == 0 || slices.ContainsFunc(ip.Tags, func(tag string) bool { return slices.Contains(tags, tag) }) {
+				resp.IPs = append(resp.IPs, ip)
+			}
+		}
+
+		resp.TotalCount = uint64(len(resp.IPs))
+		_ = json.NewEncoder(w).Encode(resp)
+	case isItem && f.ips[id] == nil:
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"type": "not_found", "resource": "ip", "resource_id": id})
+	case isItem && r.Method == http.MethodGet:
+		_ = json.NewEncoder(w).Encode(f.ips[id])
+	case isItem && r.Method == http.MethodDelete:
+		delete(f.ips, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+// newTestMachine returns the scope of the control-plane machine name of the
+// "test" cluster, which has a Private Network with static control-plane IPs.
+func newTestMachine(t *testing.T, scwClient *client.Client, name string) *scope.Machine {
+	t.Helper()
+
+	return &scope.Machine{
+		Cluster: scope.Cluster{
+			ScalewayClient: scwClient,
+			ScalewayCluster: &v1beta1.ScalewayCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: v1beta1.ScalewayClusterSpec{
+					Region: "fr-par",
+					Network: &v1beta1.NetworkSpec{
+						PrivateNetwork: &v1beta1.PrivateNetworkSpec{
+							Enabled:         true,
+							ControlPlaneIPs: []string{"172.16.0.10", "172.16.0.11"},
+						},
+					},
+				},
+				Status: v1beta1.ScalewayClusterStatus{
+					Network: &v1beta1.NetworkStatus{PrivateNetworkID: scw.StringPtr(testPNID)},
+				},
+			},
+		},
+		ScalewayMachine: &v1beta1.ScalewayMachine{ObjectMeta: metav1.ObjectMeta{Name: name}},
+		Machine: &clusterv1beta1.Machine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{clusterv1beta1.MachineControlPlaneLabel: ""},
+			},
+		},
+	}
+}
+
+func TestPrivateIPOfMachinesOfTheSameCluster(t *testing.T) {
+	ipamAPI := &fakeIPAM{ips: map[string]*ipam.IP{
+		"ip-a": {
+			ID:     "ip-a",
+			Region: scw.RegionFrPar,
+			Tags:   []string{"caps-cluster=test", "caps-node=machine-a"},
+		},
+		"ip-other": {
+			ID:     "ip-other",
+			Region: scw.RegionFrPar,
+			Tags:   []string{"caps-cluster=other", "caps-node=machine-b"},
+		},
+	}}
+
+	scwClient := fake.NewClient(t, ipamAPI)
+
+	ctx := context.Background()
+	machineA := NewService(newTestMachine(t, scwClient, "machine-a"))
+	machineB := NewService(newTestMachine(t, scwClient, "machine-b"))
+
+	ip, err := machineA.getPrivateIP(ctx, testPNID)
+	if err != nil {
+		t.Fatalf("getPrivateIP() of machine-a error = %v", err)
+	}
+
+	if ip.ID != "ip-a" {
+		t.Errorf("getPrivateIP() of machine-a = %s, want ip-a", ip.ID)
+	}
+
+	// machine-b shares the cluster tag of machine-a and has the node tag of a
+	// machine of another cluster, but none of these IPs is its own.
+	if _, err := machineB.getPrivateIP(ctx, testPNID); !errors.Is(err, client.ErrNoItemFound) {
+		t.Fatalf("getPrivateIP() of machine-b error = %v, want %v", err, client.ErrNoItemFound)
+	}
+
+	machineB.ScalewayMachine.Status.PrivateIPID = scw.StringPtr("ip-b")
+
+	if err := machineB.releasePrivateIP(ctx); err != nil {
+		t.Fatalf("releasePrivateIP() of machine-b error = %v", err)
+	}
+
+	if machineB.ScalewayMachine.Status.PrivateIPID != nil {
+		t.Errorf("releasePrivateIP() did not reset the private IP ID of machine-b")
+	}
+
+	if len(ipamAPI.ips) != 2 {
+		t.Errorf("releasePrivateIP() of machine-b released the IPs of other machines: %d IPs left", len(ipamAPI.ips))
+	}
+
+	if err := machineA.releasePrivateIP(ctx); err != nil {
+		t.Fatalf("releasePrivateIP() of machine-a error = %v", err)
+	}
+
+	if _, ok := ipamAPI.ips["ip-a"]; ok {
+		t.Errorf("releasePrivateIP() of machine-a did not release ip-a")
+	}
+}
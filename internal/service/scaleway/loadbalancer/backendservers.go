@@ -0,0 +1,288 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// ReconcileBackendServers sets the servers of the control-plane backend and of
+// the extra listener backends to the node IPs of the control-plane machines
+// of the cluster. The pool is computed from all the machines and applied at
+// once, so IPs of machines that no longer exist are removed. The servers of a
+// control-plane backend that was not created by the provider are preserved.
+//
+// When the machines of a running cluster are attached to the Private Network,
+// their public IP is replaced by their private IP one machine at a time: the
+// next machine is only switched once the private IPs of the pool pass the
+// health checks. The machines that are still pending are recorded in status.
+func (s *Service) ReconcileBackendServers(ctx context.Context) error {
+	machines, err := s.ControlPlaneMachines(ctx)
+	if err != nil {
+		return err
+	}
+
+	slices.SortFunc(machines, func(a, b infrastructurev1beta1.ScalewayMachine) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var pending []string
+
+	for _, backendID := range s.backendIDs() {
+		backend, err := s.ScalewayClient.LoadBalancer.GetBackend(&lb.ZonedAPIGetBackendRequest{
+			Zone:      s.zone,
+			BackendID: backendID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to get backend %s: %w", backendID, err)
+		}
+
+		ips, backendPending, err := s.backendServerIPs(ctx, backend, machines)
+		if err != nil {
+			return err
+		}
+
+		for _, name := range backendPending {
+			if !slices.Contains(pending, name) {
+				pending = append(pending, name)
+			}
+		}
+
+		if s.isImportedBackend(backendID) {
+			if err := s.reconcileImportedBackendServers(ctx, backend, ips); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		pool := slices.Clone(backend.Pool)
+		slices.Sort(pool)
+
+		if slices.Equal(pool, ips) {
+			continue
+		}
+
+		if _, err := s.ScalewayClient.LoadBalancer.SetBackendServers(&lb.ZonedAPISetBackendServersRequest{
+			Zone:      s.zone,
+			BackendID: backend.ID,
+			ServerIP:  ips,
+		}, scw.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to set servers of backend %s: %w", backendID, err)
+		}
+	}
+
+	slices.Sort(pending)
+	s.status().PendingBackendServers = pending
+
+	return nil
+}
+
+// isImportedBackend returns true if backendID is the ID of a control-plane
+// backend that was not created by the provider.
+func (s *Service) isImportedBackend(backendID string) bool {
+	status := s.LoadBalancerStatus()
+	if status.BackendID == nil || *status.BackendID != backendID {
+		return false
+	}
+
+	return status.ImportedBackend || s.existingBackendID() != nil || s.IsExternallyManaged()
+}
+
+// reconcileImportedBackendServers adds the IPs to the pool of a backend that
+// was not created by the provider, and removes the IPs that were previously
+// added by the provider but are no longer in ips. The other servers of the
+// pool are preserved.
+func (s *Service) reconcileImportedBackendServers(ctx context.Context, backend *lb.Backend, ips []string) error {
+	status := s.LoadBalancerStatus()
+
+	var add, remove, added []string
+
+	for _, ip := range ips {
+		switch {
+		case !slices.Contains(backend.Pool, ip):
+			add = append(add, ip)
+			added = append(added, ip)
+		case slices.Contains(status.BackendServerIPs, ip):
+			added = append(added, ip)
+		}
+	}
+
+	for _, ip := range status.BackendServerIPs {
+		if !slices.Contains(ips, ip) && slices.Contains(backend.Pool, ip) {
+			remove = append(remove, ip)
+		}
+	}
+
+	if len(add) > 0 {
+		if _, err := s.ScalewayClient.LoadBalancer.AddBackendServers(&lb.ZonedAPIAddBackendServersRequest{
+			Zone:      s.LoadBalancerZone(),
+			BackendID: backend.ID,
+			ServerIP:  add,
+		}, scw.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to add servers to backend %s: %w", backend.ID, err)
+		}
+	}
+
+	if len(remove) > 0 {
+		if _, err := s.ScalewayClient.LoadBalancer.RemoveBackendServers(&lb.ZonedAPIRemoveBackendServersRequest{
+			Zone:      s.LoadBalancerZone(),
+			BackendID: backend.ID,
+			ServerIP:  remove,
+		}, scw.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to remove servers from backend %s: %w", backend.ID, err)
+		}
+	}
+
+	status.BackendServerIPs = added
+
+	return nil
+}
+
+// backendServerIPs returns the sorted server IPs of the backend, and the names
+// of the machines that are still reached through their public IP although
+// they have a private IP.
+func (s *Service) backendServerIPs(
+	ctx context.Context,
+	backend *lb.Backend,
+	machines []infrastructurev1beta1.ScalewayMachine,
+) ([]string, []string, error) {
+	ips := []string{}
+	privateIPs := []string{}
+
+	var candidates []infrastructurev1beta1.ScalewayMachine
+
+	for _, machine := range machines {
+		private := machineAddress(machine.Status.Addresses, v1beta1.MachineInternalIP)
+		public := machineAddress(machine.Status.Addresses, v1beta1.MachineExternalIP)
+
+		if private != "" {
+			privateIPs = append(privateIPs, private)
+		}
+
+		// The machine was attached to the Private Network after it was added
+		// to the pool with its public IP.
+		if private != "" && public != "" &&
+			slices.Contains(backend.Pool, public) && !slices.Contains(backend.Pool, private) {
+			candidates = append(candidates, machine)
+			continue
+		}
+
+		if ip := nodeIP(machine.Status.Addresses); ip != "" && !slices.Contains(ips, ip) {
+			ips = append(ips, ip)
+		}
+	}
+
+	var pending []string
+
+	if len(candidates) > 0 {
+		healthy, err := s.privateServersHealthy(ctx, backend, privateIPs)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for i, machine := range candidates {
+			if i == 0 && healthy {
+				ips = append(ips, machineAddress(machine.Status.Addresses, v1beta1.MachineInternalIP))
+				continue
+			}
+
+			ips = append(ips, machineAddress(machine.Status.Addresses, v1beta1.MachineExternalIP))
+			pending = append(pending, machine.Name)
+		}
+	}
+
+	slices.Sort(ips)
+	ips = slices.Compact(ips)
+
+	return ips, pending, nil
+}
+
+// privateServersHealthy returns true if all the private IPs that are in the
+// pool of the backend pass the health checks.
+func (s *Service) privateServersHealthy(ctx context.Context, backend *lb.Backend, privateIPs []string) (bool, error) {
+	if backend.LB == nil {
+		return false, nil
+	}
+
+	stats, err := s.ScalewayClient.LoadBalancer.ListBackendStats(&lb.ZonedAPIListBackendStatsRequest{
+		Zone:      s.zone,
+		LBID:      backend.LB.ID,
+		BackendID: &backend.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("failed to list stats of backend %s: %w", backend.ID, err)
+	}
+
+	for _, ip := range backend.Pool {
+		if !slices.Contains(privateIPs, ip) {
+			continue
+		}
+
+		i := slices.IndexFunc(stats.BackendServersStats, func(stat *lb.BackendServerStats) bool {
+			return stat.IP == ip
+		})
+
+		if i == -1 || stats.BackendServersStats[i].LastHealthCheckStatus != lb.BackendServerStatsHealthCheckStatusPassed {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// backendIDs returns the IDs of the control-plane backend and of the extra
+// listener backends that are known in the status.
+func (s *Service) backendIDs() []string {
+	status := s.status()
+
+	var ids []string
+	if status.BackendID != nil {
+		ids = append(ids, *status.BackendID)
+	}
+
+	for _, listener := range status.Listeners {
+		if listener.BackendID != "" {
+			ids = append(ids, listener.BackendID)
+		}
+	}
+
+	return ids
+}
+
+// machineAddress returns the first address of the provided type, or an empty
+// string if there is none.
+func machineAddress(addresses []v1beta1.MachineAddress, addressType v1beta1.MachineAddressType) string {
+	for _, address := range addresses {
+		if address.Type == addressType {
+			return address.Address
+		}
+	}
+
+	return ""
+}
+
+// nodeIP returns the internal IP of the node if it has one, otherwise its
+// first external IP. It matches the IP that is advertised by the node.
+func nodeIP(addresses []v1beta1.MachineAddress) string {
+	var external string
+
+	for _, address := range addresses {
+		switch address.Type {
+		case v1beta1.MachineInternalIP:
+			return address.Address
+		case v1beta1.MachineExternalIP:
+			if external == "" {
+				external = address.Address
+			}
+		}
+	}
+
+	return external
+}
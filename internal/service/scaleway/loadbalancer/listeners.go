@@ -0,0 +1,218 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+)
+
+// extraListeners returns the extra listeners of the loadbalancer.
+func (s *Service) extraListeners() []v1beta1.LoadBalancerListener {
+	if s.ScalewayCluster.Spec.ControlPlaneLoadBalancer == nil {
+		return nil
+	}
+
+	return s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.ExtraListeners
+}
+
+// isExtraListener returns true if name is the name of an extra listener.
+func (s *Service) isExtraListener(name string) bool {
+	return slices.ContainsFunc(s.extraListeners(), func(l v1beta1.LoadBalancerListener) bool {
+		return l.Name == name
+	})
+}
+
+// listenerBackendPort returns the backend port of the listener.
+func listenerBackendPort(listener v1beta1.LoadBalancerListener) int32 {
+	if listener.BackendPort != nil {
+		return *listener.BackendPort
+	}
+
+	return listener.Port
+}
+
+// listenerProtocol returns the forward protocol of the listener.
+func listenerProtocol(listener v1beta1.LoadBalancerListener) lb.Protocol {
+	if listener.Protocol != nil && *listener.Protocol == v1beta1.LoadBalancerProtocolHTTP {
+		return lb.ProtocolHTTP
+	}
+
+	return lb.ProtocolTCP
+}
+
+// ensureExtraListeners ensures the frontends and backends of the extra listeners
+// exist and are up-to-date. It returns the frontends indexed by listener name.
+// Frontends and backends of listeners that were removed from the spec are
+// deleted by ensureFrontend and ensureBackend.
+func (s *Service) ensureExtraListeners(ctx context.Context, loadbalancer *lb.LB) (map[string]*lb.Frontend, error) {
+	backends, err := s.ScalewayClient.LoadBalancer.ListBackends(&lb.ZonedAPIListBackendsRequest{
+		Zone: loadbalancer.Zone,
+		LBID: loadbalancer.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	frontends, err := s.ScalewayClient.LoadBalancer.ListFrontends(&lb.ZonedAPIListFrontendsRequest{
+		Zone: loadbalancer.Zone,
+		LBID: loadbalancer.ID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	s.pruneListenersStatus()
+
+	result := make(map[string]*lb.Frontend, len(s.extraListeners()))
+
+	for _, listener := range s.extraListeners() {
+		status := s.status().Listeners[listener.Name]
+
+		backend, err := s.ensureListenerBackend(ctx, loadbalancer, backends.Backends, listener, status.BackendID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ensure backend of listener %s: %w", listener.Name, err)
+		}
+
+		frontend, err := s.ensureListenerFrontend(ctx, loadbalancer, frontends.Frontends, listener, status.FrontendID, backend)
+		if err != nil {
+			return nil, fmt.Errorf("failed to ensure frontend of listener %s: %w", listener.Name, err)
+		}
+
+		s.setStatusListener(listener.Name, v1beta1.LoadBalancerListenerStatus{
+			FrontendID: frontend.ID,
+			BackendID:  backend.ID,
+		})
+
+		result[listener.Name] = frontend
+	}
+
+	return result, nil
+}
+
+// findListenerResource returns the index of the resource of the listener in
+// a list of resources. The resource is matched by ID if it is known in the
+// status and still exists, otherwise it is matched by name.
+func findListenerResource[T any](resources []T, id func(T) string, name func(T) string, statusID, listenerName string) int {
+	var expectedID *string
+	if statusID != "" && slices.ContainsFunc(resources, func(r T) bool { return id(r) == statusID }) {
+		expectedID = &statusID
+	}
+
+	return slices.IndexFunc(resources, func(r T) bool {
+		return isResource(id(r), name(r), expectedID, listenerName)
+	})
+}
+
+func (s *Service) ensureListenerBackend(
+	ctx context.Context,
+	loadbalancer *lb.LB,
+	backends []*lb.Backend,
+	listener v1beta1.LoadBalancerListener,
+	statusID string,
+) (*lb.Backend, error) {
+	hc := toHealthCheck(listener.HealthCheck, listenerBackendPort(listener))
+
+	i := findListenerResource(backends,
+		func(b *lb.Backend) string { return b.ID },
+		func(b *lb.Backend) string { return b.Name },
+		statusID, listener.Name,
+	)
+	if i == -1 {
+		return s.ScalewayClient.LoadBalancer.CreateBackend(&lb.ZonedAPICreateBackendRequest{
+			Zone:            loadbalancer.Zone,
+			LBID:            loadbalancer.ID,
+			Name:            listener.Name,
+			ForwardProtocol: listenerProtocol(listener),
+			ForwardPort:     listenerBackendPort(listener),
+			HealthCheck:     hc,
+		}, scw.WithContext(ctx))
+	}
+
+	backend := backends[i]
+	if err := s.ensureBackendSettings(ctx, backend, listenerProtocol(listener), listenerBackendPort(listener), hc); err != nil {
+		return nil, err
+	}
+
+	return backend, nil
+}
+
+func (s *Service) ensureListenerFrontend(
+	ctx context.Context,
+	loadbalancer *lb.LB,
+	frontends []*lb.Frontend,
+	listener v1beta1.LoadBalancerListener,
+	statusID string,
+	backend *lb.Backend,
+) (*lb.Frontend, error) {
+	i := findListenerResource(frontends,
+		func(f *lb.Frontend) string { return f.ID },
+		func(f *lb.Frontend) string { return f.Name },
+		statusID, listener.Name,
+	)
+	if i == -1 {
+		return s.ScalewayClient.LoadBalancer.CreateFrontend(&lb.ZonedAPICreateFrontendRequest{
+			Zone:        loadbalancer.Zone,
+			LBID:        loadbalancer.ID,
+			Name:        listener.Name,
+			InboundPort: listener.Port,
+			BackendID:   backend.ID,
+		}, scw.WithContext(ctx))
+	}
+
+	frontend := frontends[i]
+	if frontend.InboundPort != listener.Port || frontend.Backend == nil || frontend.Backend.ID != backend.ID {
+		updated, err := s.ScalewayClient.LoadBalancer.UpdateFrontend(&lb.ZonedAPIUpdateFrontendRequest{
+			Zone:           loadbalancer.Zone,
+			FrontendID:     frontend.ID,
+			Name:           frontend.Name,
+			InboundPort:    listener.Port,
+			BackendID:      backend.ID,
+			TimeoutClient:  frontend.TimeoutClient,
+			CertificateIDs: scw.StringsPtr(frontend.CertificateIDs),
+			EnableHTTP3:    frontend.EnableHTTP3,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update frontend: %w", err)
+		}
+
+		frontend = updated
+	}
+
+	return frontend, nil
+}
+
+// setStatusListener sets the IDs of the listener in the status.
+func (s *Service) setStatusListener(name string, listenerStatus v1beta1.LoadBalancerListenerStatus) {
+	status := s.status()
+
+	if status.Listeners == nil {
+		status.Listeners = make(map[string]v1beta1.LoadBalancerListenerStatus)
+	}
+
+	status.Listeners[name] = listenerStatus
+}
+
+// pruneListenersStatus removes the listeners that were removed from the spec
+// (and their ACLs) from the status.
+func (s *Service) pruneListenersStatus() {
+	status := s.status()
+
+	for name := range status.Listeners {
+		if s.isExtraListener(name) {
+			continue
+		}
+
+		delete(status.Listeners, name)
+
+		for key := range status.ACLIDs {
+			if strings.HasPrefix(key, name+"/") {
+				delete(status.ACLIDs, key)
+			}
+		}
+	}
+}
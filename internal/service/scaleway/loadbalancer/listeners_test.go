@@ -0,0 +1,109 @@
+package loadbalancer
+
+import (
+	"testing"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestListenerBackendPortAndProtocol(t *testing.T) {
+	http := v1beta1.LoadBalancerProtocolHTTP
+	tcp := v1beta1.LoadBalancerProtocolTCP
+
+	tests := []struct {
+		name         string
+		listener     v1beta1.LoadBalancerListener
+		wantPort     int32
+		wantProtocol lb.Protocol
+	}{
+		{
+			name:         "defaults",
+			listener:     v1beta1.LoadBalancerListener{Name: "talos", Port: 50000},
+			wantPort:     50000,
+			wantProtocol: lb.ProtocolTCP,
+		},
+		{
+			name:         "backend port",
+			listener:     v1beta1.LoadBalancerListener{Name: "rke2", Port: 9345, BackendPort: scw.Int32Ptr(19345)},
+			wantPort:     19345,
+			wantProtocol: lb.ProtocolTCP,
+		},
+		{
+			name:         "TCP protocol",
+			listener:     v1beta1.LoadBalancerListener{Name: "rke2", Port: 9345, Protocol: &tcp},
+			wantPort:     9345,
+			wantProtocol: lb.ProtocolTCP,
+		},
+		{
+			name:         "HTTP protocol",
+			listener:     v1beta1.LoadBalancerListener{Name: "web", Port: 80, Protocol: &http},
+			wantPort:     80,
+			wantProtocol: lb.ProtocolHTTP,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := listenerBackendPort(tt.listener); got != tt.wantPort {
+				t.Errorf("listenerBackendPort() = %d, want %d", got, tt.wantPort)
+			}
+
+			if got := listenerProtocol(tt.listener); got != tt.wantProtocol {
+				t.Errorf("listenerProtocol() = %s, want %s", got, tt.wantProtocol)
+			}
+		})
+	}
+}
+
+func TestFindListenerResource(t *testing.T) {
+	backends := []*lb.Backend{
+		{ID: "1", Name: "control-plane"},
+		{ID: "2", Name: "talos"},
+		{ID: "3", Name: "renamed"},
+	}
+
+	tests := []struct {
+		name         string
+		statusID     string
+		listenerName string
+		want         int
+	}{
+		{
+			name:         "match by name",
+			listenerName: "talos",
+			want:         1,
+		},
+		{
+			name:         "no match",
+			listenerName: "rke2",
+			want:         -1,
+		},
+		{
+			name:         "match by status ID",
+			statusID:     "3",
+			listenerName: "talos",
+			want:         2,
+		},
+		{
+			name:         "deleted resource is matched by name",
+			statusID:     "4",
+			listenerName: "talos",
+			want:         1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findListenerResource(backends,
+				func(b *lb.Backend) string { return b.ID },
+				func(b *lb.Backend) string { return b.Name },
+				tt.statusID, tt.listenerName,
+			)
+			if got != tt.want {
+				t.Errorf("findListenerResource() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
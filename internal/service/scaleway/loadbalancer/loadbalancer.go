@@ -5,40 +5,323 @@ import (
 	"errors"
 	"fmt"
 	"net/netip"
+	"reflect"
+	"strings"
 
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
 	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"golang.org/x/exp/slices"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 const (
 	ControlPlaneBackendName         = "control-plane"
 	ControlPlaneFrontendName        = "control-plane"
-	BackendControlPlanePort         = 6443
+	DefaultBackendControlPlanePort  = 6443
 	DefaultFrontendControlPlanePort = 6443
+	DefaultHealthCheckMaxRetries    = 5
+	DefaultHealthCheckPath          = "/readyz"
 )
 
 var ErrLoadBalancerNotReady = errors.New("loadbalancer is not ready")
 
 type Service struct {
 	*scope.Cluster
+
+	// zone of the loadbalancer managed by the service.
+	zone scw.Zone
 }
 
+// NewService returns a service that manages the loadbalancer of the main zone.
 func NewService(clusterScope *scope.Cluster) *Service {
 	return &Service{
 		Cluster: clusterScope,
+		zone:    clusterScope.LoadBalancerZone(),
+	}
+}
+
+// forZone returns a service that manages the loadbalancer of the provided zone.
+func (s *Service) forZone(zone scw.Zone) *Service {
+	return &Service{
+		Cluster: s.Cluster,
+		zone:    zone,
+	}
+}
+
+// isMainZone returns true if the service manages the main loadbalancer.
+func (s *Service) isMainZone() bool {
+	return s.zone == s.LoadBalancerZone()
+}
+
+// status returns the status of the loadbalancer of the service zone. The
+// status is initialized if it is not set yet.
+func (s *Service) status() *v1beta1.LoadBalancerZoneStatus {
+	status := s.LoadBalancerStatus()
+
+	if s.isMainZone() {
+		status.Zone = s.zone.String()
+		return &status.LoadBalancerZoneStatus
+	}
+
+	for i := range status.ExtraZones {
+		if status.ExtraZones[i].Zone == s.zone.String() {
+			return &status.ExtraZones[i]
+		}
+	}
+
+	status.ExtraZones = append(status.ExtraZones, v1beta1.LoadBalancerZoneStatus{Zone: s.zone.String()})
+
+	return &status.ExtraZones[len(status.ExtraZones)-1]
+}
+
+// existingLBID returns the ID of the existing loadbalancer provided by the user.
+// Existing resources are only used in the main zone.
+func (s *Service) existingLBID() *string {
+	if !s.isMainZone() || s.ScalewayCluster.Spec.ControlPlaneLoadBalancer == nil {
+		return nil
 	}
+
+	return s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.ID
+}
+
+// existingFrontendID returns the ID of the existing frontend provided by the user.
+func (s *Service) existingFrontendID() *string {
+	if !s.isMainZone() || s.ScalewayCluster.Spec.ControlPlaneLoadBalancer == nil {
+		return nil
+	}
+
+	return s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.FrontendID
+}
+
+// existingBackendID returns the ID of the existing backend provided by the user.
+func (s *Service) existingBackendID() *string {
+	if !s.isMainZone() || s.ScalewayCluster.Spec.ControlPlaneLoadBalancer == nil {
+		return nil
+	}
+
+	return s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.BackendID
+}
+
+// frontendPort returns the port of the control-plane frontend.
+func (s *Service) frontendPort() int32 {
+	if s.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
+		s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Port != nil {
+		return *s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.Port
+	}
+
+	if s.Cluster.Cluster != nil &&
+		s.Cluster.Cluster.Spec.ClusterNetwork != nil &&
+		s.Cluster.Cluster.Spec.ClusterNetwork.APIServerPort != nil {
+		return *s.Cluster.Cluster.Spec.ClusterNetwork.APIServerPort
+	}
+
+	return DefaultFrontendControlPlanePort
+}
+
+// backendPort returns the port of the kube-apiserver on control-plane nodes.
+func (s *Service) backendPort() int32 {
+	if s.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
+		s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.BackendPort != nil {
+		return *s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.BackendPort
+	}
+
+	return DefaultBackendControlPlanePort
+}
+
+// healthCheck returns the health check of the control-plane backend.
+func (s *Service) healthCheck() *lb.HealthCheck {
+	var spec *v1beta1.LoadBalancerHealthCheck
+	if s.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil {
+		spec = s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.HealthCheck
+	}
+
+	return toHealthCheck(spec, s.backendPort())
+}
+
+// toHealthCheck converts a health check spec to a loadbalancer health check on
+// the provided port.
+func toHealthCheck(spec *v1beta1.LoadBalancerHealthCheck, port int32) *lb.HealthCheck {
+	hc := &lb.HealthCheck{
+		Port:            port,
+		CheckMaxRetries: DefaultHealthCheckMaxRetries,
+		TCPConfig:       &lb.HealthCheckTCPConfig{},
+	}
+
+	if spec == nil {
+		return hc
+	}
+
+	if spec.MaxRetries != nil {
+		hc.CheckMaxRetries = *spec.MaxRetries
+	}
+
+	if spec.Interval != nil {
+		hc.CheckDelay = &spec.Interval.Duration
+	}
+
+	if spec.Timeout != nil {
+		hc.CheckTimeout = &spec.Timeout.Duration
+	}
+
+	if spec.Protocol != nil && *spec.Protocol == v1beta1.LoadBalancerHealthCheckProtocolHTTPS {
+		path := DefaultHealthCheckPath
+		if spec.Path != nil {
+			path = *spec.Path
+		}
+
+		hc.TCPConfig = nil
+		hc.HTTPSConfig = &lb.HealthCheckHTTPSConfig{
+			URI:    path,
+			Method: "GET",
+			Code:   scw.Int32Ptr(200),
+		}
+	}
+
+	return hc
+}
+
+// healthCheckMatches returns true if the current health check matches the
+// expected health check. The interval and timeout are only compared if they are
+// set in the expected health check.
+func healthCheckMatches(current, expected *lb.HealthCheck) bool {
+	if current == nil {
+		return false
+	}
+
+	if current.Port != expected.Port || current.CheckMaxRetries != expected.CheckMaxRetries {
+		return false
+	}
+
+	if expected.CheckDelay != nil && (current.CheckDelay == nil || *current.CheckDelay != *expected.CheckDelay) {
+		return false
+	}
+
+	if expected.CheckTimeout != nil && (current.CheckTimeout == nil || *current.CheckTimeout != *expected.CheckTimeout) {
+		return false
+	}
+
+	if (current.TCPConfig != nil) != (expected.TCPConfig != nil) {
+		return false
+	}
+
+	if expected.HTTPSConfig != nil {
+		return current.HTTPSConfig != nil &&
+			current.HTTPSConfig.URI == expected.HTTPSConfig.URI &&
+			current.HTTPSConfig.Method == expected.HTTPSConfig.Method &&
+			reflect.DeepEqual(current.HTTPSConfig.Code, expected.HTTPSConfig.Code)
+	}
+
+	return current.HTTPSConfig == nil
+}
+
+// ensureBackendSettings updates the forward protocol, the forward port and the
+// health check of the backend if they don't match the expected values.
+func (s *Service) ensureBackendSettings(ctx context.Context, backend *lb.Backend, protocol lb.Protocol, forwardPort int32, hc *lb.HealthCheck) error {
+	if backend.ForwardProtocol != protocol || backend.ForwardPort != forwardPort {
+		updated, err := s.ScalewayClient.LoadBalancer.UpdateBackend(&lb.ZonedAPIUpdateBackendRequest{
+			Zone:                     s.zone,
+			BackendID:                backend.ID,
+			Name:                     backend.Name,
+			ForwardProtocol:          protocol,
+			ForwardPort:              forwardPort,
+			ForwardPortAlgorithm:     backend.ForwardPortAlgorithm,
+			StickySessions:           backend.StickySessions,
+			StickySessionsCookieName: backend.StickySessionsCookieName,
+			SendProxyV2:              backend.SendProxyV2,
+			TimeoutServer:            backend.TimeoutServer,
+			TimeoutConnect:           backend.TimeoutConnect,
+			TimeoutTunnel:            backend.TimeoutTunnel,
+			OnMarkedDownAction:       backend.OnMarkedDownAction,
+			ProxyProtocol:            backend.ProxyProtocol,
+			FailoverHost:             backend.FailoverHost,
+			SslBridging:              backend.SslBridging,
+			IgnoreSslServerVerify:    backend.IgnoreSslServerVerify,
+			RedispatchAttemptCount:   backend.RedispatchAttemptCount,
+			MaxRetries:               backend.MaxRetries,
+			MaxConnections:           backend.MaxConnections,
+			TimeoutQueue:             backend.TimeoutQueue,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to update backend: %w", err)
+		}
+
+		backend.ForwardProtocol = updated.ForwardProtocol
+		backend.ForwardPort = updated.ForwardPort
+	}
+
+	if !healthCheckMatches(backend.HealthCheck, hc) {
+		updated, err := s.ScalewayClient.LoadBalancer.UpdateHealthCheck(&lb.ZonedAPIUpdateHealthCheckRequest{
+			Zone:            s.zone,
+			BackendID:       backend.ID,
+			Port:            hc.Port,
+			CheckDelay:      hc.CheckDelay,
+			CheckTimeout:    hc.CheckTimeout,
+			CheckMaxRetries: hc.CheckMaxRetries,
+			TCPConfig:       hc.TCPConfig,
+			HTTPSConfig:     hc.HTTPSConfig,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to update health check: %w", err)
+		}
+
+		backend.HealthCheck = updated
+	}
+
+	return nil
+}
+
+// getLB returns the control-plane loadbalancer. If an existing loadbalancer is
+// provided by the user, it is retrieved by this ID. Otherwise, it is retrieved
+// by its ID if it is known in the status, or searched by name. It returns
+// client.ErrNoItemFound if the loadbalancer does not exist.
+func (s *Service) getLB(ctx context.Context, zone scw.Zone) (*lb.LB, error) {
+	if lbID := s.existingLBID(); lbID != nil {
+		loadbalancer, err := s.ScalewayClient.LoadBalancer.GetLB(&lb.ZonedAPIGetLBRequest{
+			Zone: zone,
+			LBID: *lbID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if client.IsNotFoundError(err) {
+				return nil, client.ErrNoItemFound
+			}
+
+			return nil, err
+		}
+
+		return loadbalancer, nil
+	}
+
+	if status := s.status(); status.LoadBalancerID != nil {
+		loadbalancer, err := s.ScalewayClient.LoadBalancer.GetLB(&lb.ZonedAPIGetLBRequest{
+			Zone: zone,
+			LBID: *status.LoadBalancerID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return loadbalancer, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ScalewayClient.FindLoadBalancerByName(ctx, zone, s.Name())
 }
 
-// TODO: allow migrating the load balancer to other types.
 func (s *Service) getOrCreateLB(ctx context.Context, zone scw.Zone) (*lb.LB, error) {
-	loadbalancer, err := s.ScalewayClient.FindLoadBalancerByName(ctx, zone, s.Name())
+	loadbalancer, err := s.getLB(ctx, zone)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return nil, err
 	}
 
+	if loadbalancer == nil && s.existingLBID() != nil {
+		return nil, fmt.Errorf("existing loadbalancer %q not found", *s.existingLBID())
+	}
+
 	if loadbalancer == nil {
 		var ipID *string
 
@@ -52,31 +335,131 @@ func (s *Service) getOrCreateLB(ctx context.Context, zone scw.Zone) (*lb.LB, err
 			ipID = &ip.ID
 		}
 
+		var assignFlexibleIP, assignFlexibleIPv6 *bool
+
+		// A private loadbalancer has no public IP.
+		if s.HasPrivateLoadBalancer() {
+			assignFlexibleIP = scw.BoolPtr(false)
+		}
+
+		// The IPv4 must be explicitly requested when an IPv6 is assigned.
+		if s.HasIPv6LoadBalancer() {
+			assignFlexibleIPv6 = scw.BoolPtr(true)
+
+			if ipID == nil {
+				assignFlexibleIP = scw.BoolPtr(true)
+			}
+		}
+
 		loadbalancer, err = s.ScalewayClient.LoadBalancer.CreateLB(&lb.ZonedAPICreateLBRequest{
-			Zone: zone,
-			Name: s.Name(),
-			Type: s.LoadBalancerType(),
-			IPID: ipID,
+			Zone:               zone,
+			Name:               s.Name(),
+			Type:               s.LoadBalancerType(),
+			IPID:               ipID,
+			AssignFlexibleIP:   assignFlexibleIP,
+			AssignFlexibleIPv6: assignFlexibleIPv6,
+			Tags:               s.Tags(),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// An existing loadbalancer is not owned by the cluster, it is always
+	// retrieved by its ID.
+	if s.existingLBID() == nil {
+		if err := s.ensureLBTags(ctx, loadbalancer); err != nil {
+			return nil, err
+		}
+	}
+
+	s.setStatusLB(loadbalancer)
+	s.status().ImportedLoadBalancer = s.existingLBID() != nil
+
 	return loadbalancer, nil
 }
 
-func (s *Service) ensurePrivateNetwork(ctx context.Context, loadbalancer *lb.LB, pnID *string) error {
-	if pnID == nil {
+// ensureLBTags adds the tags of the cluster to the loadbalancer. Loadbalancers
+// created by previous versions of the provider have no tags, they are needed
+// to restore the status of the cluster.
+func (s *Service) ensureLBTags(ctx context.Context, loadbalancer *lb.LB) error {
+	tags, ok := client.MergeTags(loadbalancer.Tags, s.Tags())
+	if !ok {
+		return nil
+	}
+
+	if _, err := s.ScalewayClient.LoadBalancer.UpdateLB(&lb.ZonedAPIUpdateLBRequest{
+		Zone:                  loadbalancer.Zone,
+		LBID:                  loadbalancer.ID,
+		Name:                  loadbalancer.Name,
+		Description:           loadbalancer.Description,
+		Tags:                  tags,
+		SslCompatibilityLevel: loadbalancer.SslCompatibilityLevel,
+	}, scw.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to tag loadbalancer: %w", err)
+	}
+
+	loadbalancer.Tags = tags
+
+	return nil
+}
+
+// setStatusLB sets the IDs of the loadbalancer and its IPs in the status.
+func (s *Service) setStatusLB(loadbalancer *lb.LB) {
+	status := s.status()
+	status.LoadBalancerID = &loadbalancer.ID
+	status.IPIDs = make([]string, 0, len(loadbalancer.IP))
+	status.IPs = make([]string, 0, len(loadbalancer.IP))
+	for _, ip := range loadbalancer.IP {
+		status.IPIDs = append(status.IPIDs, ip.ID)
+		status.IPs = append(status.IPs, ip.IPAddress)
+	}
+	status.Type = scw.StringPtr(loadbalancer.Type)
+	status.State = scw.StringPtr(loadbalancer.Status.String())
+}
+
+// ensureType migrates the loadbalancer if its type does not match the type in
+// the spec. ErrLoadBalancerNotReady is returned while the migration is started.
+func (s *Service) ensureType(ctx context.Context, loadbalancer *lb.LB) error {
+	// Existing loadbalancers are never migrated.
+	if s.existingLBID() != nil || strings.EqualFold(loadbalancer.Type, s.LoadBalancerType()) {
 		return nil
 	}
 
+	log.FromContext(ctx).Info(
+		"migrating loadbalancer",
+		"currentType", loadbalancer.Type,
+		"type", s.LoadBalancerType(),
+	)
+
+	migrated, err := s.ScalewayClient.LoadBalancer.MigrateLB(&lb.ZonedAPIMigrateLBRequest{
+		Zone: loadbalancer.Zone,
+		LBID: loadbalancer.ID,
+		Type: s.LoadBalancerType(),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to migrate loadbalancer to type %q: %w", s.LoadBalancerType(), err)
+	}
+
+	s.setStatusLB(migrated)
+
+	return ErrLoadBalancerNotReady
+}
+
+// ensurePrivateNetwork ensures the loadbalancer is attached to the Private
+// Network and returns its IP in this Private Network. The returned IP is nil
+// if it is not known yet.
+func (s *Service) ensurePrivateNetwork(ctx context.Context, loadbalancer *lb.LB, pnID *string) (*string, error) {
+	if pnID == nil {
+		return nil, nil
+	}
+
 	lbPNs, err := s.ScalewayClient.LoadBalancer.ListLBPrivateNetworks(&lb.ZonedAPIListLBPrivateNetworksRequest{
 		Zone: loadbalancer.Zone,
 		LBID: loadbalancer.ID,
 	}, scw.WithAllPages(), scw.WithContext(ctx))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	found := slices.IndexFunc(lbPNs.PrivateNetwork, func(lbPN *lb.PrivateNetwork) bool {
@@ -84,17 +467,43 @@ func (s *Service) ensurePrivateNetwork(ctx context.Context, loadbalancer *lb.LB,
 	})
 
 	if found == -1 {
-		if _, err := s.ScalewayClient.LoadBalancer.AttachPrivateNetwork(&lb.ZonedAPIAttachPrivateNetworkRequest{
+		req := &lb.ZonedAPIAttachPrivateNetworkRequest{
 			Zone:             loadbalancer.Zone,
 			LBID:             loadbalancer.ID,
 			PrivateNetworkID: *pnID,
 			IpamConfig:       &lb.PrivateNetworkIpamConfig{},
-		}, scw.WithContext(ctx)); err != nil {
-			return err
 		}
+
+		if s.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
+			s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.PrivateIP != nil {
+			req.IpamConfig = nil
+			req.StaticConfig = &lb.PrivateNetworkStaticConfig{
+				IPAddress: scw.StringsPtr([]string{*s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.PrivateIP}),
+			}
+		}
+
+		if _, err := s.ScalewayClient.LoadBalancer.AttachPrivateNetwork(req, scw.WithContext(ctx)); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
 	}
 
-	return nil
+	lbPN := lbPNs.PrivateNetwork[found]
+	if lbPN.StaticConfig != nil && lbPN.StaticConfig.IPAddress != nil && len(*lbPN.StaticConfig.IPAddress) > 0 {
+		return &(*lbPN.StaticConfig.IPAddress)[0], nil
+	}
+
+	ip, err := s.ScalewayClient.FindIPv4ByLoadBalancerID(ctx, s.Region(), loadbalancer.ID, *pnID)
+	if err != nil {
+		if errors.Is(err, client.ErrNoItemFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return scw.StringPtr(ip.IP.String()), nil
 }
 
 func (s *Service) ensureBackend(ctx context.Context, loadbalancer *lb.LB) (*lb.Backend, error) {
@@ -106,13 +515,32 @@ func (s *Service) ensureBackend(ctx context.Context, loadbalancer *lb.LB) (*lb.B
 		return nil, err
 	}
 
+	// Fallback to discovery by name if the backend from the status is gone.
+	backendID := s.status().BackendID
+	if backendID != nil && !slices.ContainsFunc(backends.Backends, func(b *lb.Backend) bool {
+		return b.ID == *backendID
+	}) {
+		backendID = nil
+	}
+
+	// Always use the existing backend if one is provided by the user.
+	if existingBackendID := s.existingBackendID(); existingBackendID != nil {
+		backendID = existingBackendID
+	}
+
 	var backend *lb.Backend
 	for _, backendCandidate := range backends.Backends {
-		if backendCandidate.Name == ControlPlaneBackendName {
+		if backend == nil && isResource(backendCandidate.ID, backendCandidate.Name, backendID, ControlPlaneBackendName) {
 			backend = backendCandidate
 			continue
 		}
 
+		// Other backends of an existing loadbalancer are not managed. Backends
+		// of extra listeners are managed by ensureExtraListeners.
+		if s.existingLBID() != nil || s.isExtraListener(backendCandidate.Name) {
+			continue
+		}
+
 		if err := s.ScalewayClient.LoadBalancer.DeleteBackend(&lb.ZonedAPIDeleteBackendRequest{
 			Zone:      loadbalancer.Zone,
 			BackendID: backendCandidate.ID,
@@ -121,24 +549,34 @@ func (s *Service) ensureBackend(ctx context.Context, loadbalancer *lb.LB) (*lb.B
 		}
 	}
 
+	if backend == nil && s.existingBackendID() != nil {
+		return nil, fmt.Errorf("existing backend %q not found", *s.existingBackendID())
+	}
+
 	if backend == nil {
 		backend, err = s.ScalewayClient.LoadBalancer.CreateBackend(&lb.ZonedAPICreateBackendRequest{
 			Zone:            loadbalancer.Zone,
 			LBID:            loadbalancer.ID,
 			Name:            ControlPlaneBackendName,
 			ForwardProtocol: lb.ProtocolTCP,
-			ForwardPort:     BackendControlPlanePort,
-			HealthCheck: &lb.HealthCheck{
-				Port:            BackendControlPlanePort,
-				CheckMaxRetries: 5,
-				TCPConfig:       &lb.HealthCheckTCPConfig{},
-			},
+			ForwardPort:     s.backendPort(),
+			HealthCheck:     s.healthCheck(),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// The settings of an existing backend are not managed.
+	if s.existingBackendID() == nil {
+		if err := s.ensureBackendSettings(ctx, backend, lb.ProtocolTCP, s.backendPort(), s.healthCheck()); err != nil {
+			return nil, err
+		}
+	}
+
+	s.status().BackendID = &backend.ID
+	s.status().ImportedBackend = s.existingBackendID() != nil
+
 	return backend, nil
 }
 
@@ -151,13 +589,32 @@ func (s *Service) ensureFrontend(ctx context.Context, loadbalancer *lb.LB, backe
 		return nil, err
 	}
 
+	// Fallback to discovery by name if the frontend from the status is gone.
+	frontendID := s.status().FrontendID
+	if frontendID != nil && !slices.ContainsFunc(frontends.Frontends, func(f *lb.Frontend) bool {
+		return f.ID == *frontendID
+	}) {
+		frontendID = nil
+	}
+
+	// Always use the existing frontend if one is provided by the user.
+	if existingFrontendID := s.existingFrontendID(); existingFrontendID != nil {
+		frontendID = existingFrontendID
+	}
+
 	var frontend *lb.Frontend
 	for _, frontendCandidate := range frontends.Frontends {
-		if frontendCandidate.Name == ControlPlaneFrontendName {
+		if frontend == nil && isResource(frontendCandidate.ID, frontendCandidate.Name, frontendID, ControlPlaneFrontendName) {
 			frontend = frontendCandidate
 			continue
 		}
 
+		// Other frontends of an existing loadbalancer are not managed. Frontends
+		// of extra listeners are managed by ensureExtraListeners.
+		if s.existingLBID() != nil || s.isExtraListener(frontendCandidate.Name) {
+			continue
+		}
+
 		if err := s.ScalewayClient.LoadBalancer.DeleteFrontend(&lb.ZonedAPIDeleteFrontendRequest{
 			Zone:       loadbalancer.Zone,
 			FrontendID: frontendCandidate.ID,
@@ -166,26 +623,115 @@ func (s *Service) ensureFrontend(ctx context.Context, loadbalancer *lb.LB, backe
 		}
 	}
 
+	if frontend == nil && s.existingFrontendID() != nil {
+		return nil, fmt.Errorf("existing frontend %q not found", *s.existingFrontendID())
+	}
+
 	if frontend == nil {
 		frontend, err = s.ScalewayClient.LoadBalancer.CreateFrontend(&lb.ZonedAPICreateFrontendRequest{
 			Zone:        loadbalancer.Zone,
 			LBID:        loadbalancer.ID,
 			Name:        ControlPlaneFrontendName,
-			InboundPort: DefaultFrontendControlPlanePort,
+			InboundPort: s.frontendPort(),
 			BackendID:   backend.ID,
-		})
+		}, scw.WithContext(ctx))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// The port of an existing frontend is not managed.
+	if s.existingFrontendID() == nil && frontend.InboundPort != s.frontendPort() {
+		frontend, err = s.ScalewayClient.LoadBalancer.UpdateFrontend(&lb.ZonedAPIUpdateFrontendRequest{
+			Zone:           loadbalancer.Zone,
+			FrontendID:     frontend.ID,
+			Name:           frontend.Name,
+			InboundPort:    s.frontendPort(),
+			BackendID:      backend.ID,
+			TimeoutClient:  frontend.TimeoutClient,
+			CertificateIDs: scw.StringsPtr(frontend.CertificateIDs),
+			EnableHTTP3:    frontend.EnableHTTP3,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to update frontend: %w", err)
+		}
+	}
+
+	if s.existingFrontendID() != nil && (frontend.Backend == nil || frontend.Backend.ID != backend.ID) {
+		return nil, fmt.Errorf("frontend %q does not forward traffic to backend %q", frontend.ID, backend.ID)
+	}
+
+	s.status().FrontendID = &frontend.ID
+	s.status().ImportedFrontend = s.existingFrontendID() != nil
+
 	return frontend, nil
 }
 
+// isResource returns true if the resource with the provided ID and name is the
+// expected resource. The resource is matched by ID if the expected ID is known,
+// otherwise it is matched by name.
+func isResource(id, name string, expectedID *string, expectedName string) bool {
+	if expectedID != nil {
+		return id == *expectedID
+	}
+
+	return name == expectedName
+}
+
+// aclStatusKey returns the key of the ACL with the provided name in the status.
+// The names of the ACLs of extra listeners are prefixed by the listener name.
+func aclStatusKey(listener, name string) string {
+	if listener == ControlPlaneFrontendName {
+		return name
+	}
+
+	return listener + "/" + name
+}
+
+// getACL returns the ACL with the provided name. It is retrieved by its ID if
+// it is known in the status, otherwise it is searched by name. It returns
+// client.ErrNoItemFound if the ACL does not exist.
+func (s *Service) getACL(ctx context.Context, frontendID, key, name string) (*lb.ACL, error) {
+	if aclID, ok := s.status().ACLIDs[key]; ok {
+		acl, err := s.ScalewayClient.LoadBalancer.GetACL(&lb.ZonedAPIGetACLRequest{
+			Zone:  s.zone,
+			ACLID: aclID,
+		}, scw.WithContext(ctx))
+		if err == nil && acl.Frontend != nil && acl.Frontend.ID == frontendID {
+			return acl, nil
+		}
+
+		if err != nil && !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ScalewayClient.FindLoadBalancerACLByName(ctx, s.zone, frontendID, name)
+}
+
+// setStatusACLID sets the ID of the ACL with the provided key in the status.
+// If id is nil, the ACL is removed from the status.
+func (s *Service) setStatusACLID(key string, id *string) {
+	status := s.status()
+
+	if id == nil {
+		delete(status.ACLIDs, key)
+		return
+	}
+
+	if status.ACLIDs == nil {
+		status.ACLIDs = make(map[string]string)
+	}
+
+	status.ACLIDs[key] = *id
+}
+
 // ensureACL ensures the ACL with specified parameters exists or doesn't exist.
 // If the ACL doesn't contain any IP, this method will ensure the ACL doesn't exist.
-func (s *Service) ensureACL(ctx context.Context, frontendID, name string, ips []string, deny bool, index int32) error {
-	acl, err := s.ScalewayClient.FindLoadBalancerACLByName(ctx, s.LoadBalancerZone(), frontendID, name)
+func (s *Service) ensureACL(ctx context.Context, frontendID, listener, name string, ips []string, deny bool, index int32) error {
+	key := aclStatusKey(listener, name)
+
+	acl, err := s.getACL(ctx, frontendID, key, name)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return err
 	}
@@ -194,13 +740,15 @@ func (s *Service) ensureACL(ctx context.Context, frontendID, name string, ips []
 	if len(ips) == 0 {
 		if acl != nil {
 			if err := s.ScalewayClient.LoadBalancer.DeleteACL(&lb.ZonedAPIDeleteACLRequest{
-				Zone:  s.LoadBalancerZone(),
+				Zone:  s.zone,
 				ACLID: acl.ID,
 			}, scw.WithContext(ctx)); err != nil {
 				return err
 			}
 		}
 
+		s.setStatusACLID(key, nil)
+
 		return nil
 	}
 
@@ -211,22 +759,29 @@ func (s *Service) ensureACL(ctx context.Context, frontendID, name string, ips []
 
 	// Create ACL if it does not exist.
 	if acl == nil {
-		_, err := s.ScalewayClient.LoadBalancer.CreateACL(&lb.ZonedAPICreateACLRequest{
-			Zone:       s.LoadBalancerZone(),
+		newACL, err := s.ScalewayClient.LoadBalancer.CreateACL(&lb.ZonedAPICreateACLRequest{
+			Zone:       s.zone,
 			FrontendID: frontendID,
 			Name:       name,
 			Index:      index,
 			Action:     &lb.ACLAction{Type: action},
 			Match:      &lb.ACLMatch{IPSubnet: scw.StringSlicePtr(ips)},
 		}, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
 
-		return err
+		s.setStatusACLID(key, &newACL.ID)
+
+		return nil
 	}
 
+	s.setStatusACLID(key, &acl.ID)
+
 	// Update ACL if ips are different.
 	if acl.Match == nil || !slices.Equal(scw.StringSlicePtr(ips), acl.Match.IPSubnet) {
 		_, err = s.ScalewayClient.LoadBalancer.UpdateACL(&lb.ZonedAPIUpdateACLRequest{
-			Zone:   s.LoadBalancerZone(),
+			Zone:   s.zone,
 			ACLID:  acl.ID,
 			Name:   name,
 			Action: &lb.ACLAction{Type: action},
@@ -239,8 +794,17 @@ func (s *Service) ensureACL(ctx context.Context, frontendID, name string, ips []
 	return nil
 }
 
-func (s *Service) ensureACLs(ctx context.Context, frontend *lb.Frontend, pnID *string) error {
-	// Set the Allowed Ranges ACL.
+// loadBalancerACL is an ACL that is set on all frontends of the loadbalancer.
+type loadBalancerACL struct {
+	name  string
+	ips   []string
+	deny  bool
+	index int32
+}
+
+// acls returns the ACLs that must be set on all frontends of the loadbalancer.
+// ACLs without IPs must not exist.
+func (s *Service) acls(ctx context.Context, pnID *string) ([]loadBalancerACL, error) {
 	var (
 		allowedRanges []string
 		denyAll       []string
@@ -251,15 +815,27 @@ func (s *Service) ensureACLs(ctx context.Context, frontend *lb.Frontend, pnID *s
 		denyAll = []string{"0.0.0.0/0", "::/0"}
 	}
 
-	if err := s.ensureACL(ctx, frontend.ID, "allowed-ranges", allowedRanges, false, 1); err != nil {
-		return fmt.Errorf("failed to set allowed-ranges ACL: %w", err)
+	// Set the Allowed Ranges ACL.
+	acls := []loadBalancerACL{{name: "allowed-ranges", ips: allowedRanges, index: 1}}
+
+	// Set the Management Cluster ACL. It is only needed when the other IPs
+	// are denied.
+	var managementIPs []string
+	if len(denyAll) > 0 {
+		var err error
+		managementIPs, err = s.ManagementEgressIPs(ctx)
+		if err != nil {
+			return nil, err
+		}
 	}
 
+	acls = append(acls, loadBalancerACL{name: "management-cluster", ips: managementIPs, index: 1})
+
 	// Set the Public Gateway ACL.
 	if pnID != nil && s.HasPrivateNetwork() {
 		gws, err := s.ScalewayClient.FindGatewaysByPrivateNetworkID(ctx, s.Zones(s.ScalewayClient.VPCGW.Zones()), *pnID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		var ips []string
@@ -270,22 +846,72 @@ func (s *Service) ensureACLs(ctx context.Context, frontend *lb.Frontend, pnID *s
 			}
 		}
 
-		if err := s.ensureACL(ctx, frontend.ID, "public-gateway", ips, false, 2); err != nil {
-			return err
+		acls = append(acls, loadBalancerACL{name: "public-gateway", ips: ips, index: 2})
+
+		// Allow nodes to reach the loadbalancer through its private IP.
+		var subnets []string
+		if len(denyAll) > 0 {
+			pn, err := s.ScalewayClient.VPC.GetPrivateNetwork(&vpc.GetPrivateNetworkRequest{
+				Region:           s.Region(),
+				PrivateNetworkID: *pnID,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return nil, err
+			}
+
+			for _, subnet := range pn.Subnets {
+				subnets = append(subnets, subnet.Subnet.String())
+			}
 		}
+
+		acls = append(acls, loadBalancerACL{name: "private-network", ips: subnets, index: 2})
+	}
+
+	// Set the Nodes ACLs.
+	nodesACLs, err := s.nodesACLs(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	acls = append(acls, nodesACLs...)
+
 	// Set the Deny All ACL. If denyAll is empty, it will not be created (or it
 	// will be deleted if it exists).
-	if err := s.ensureACL(ctx, frontend.ID, "deny-all", denyAll, true, 4); err != nil {
+	acls = append(acls, loadBalancerACL{name: "deny-all", ips: denyAll, deny: true, index: 4})
+
+	return acls, nil
+}
+
+// ensureACLs ensures the ACLs are set on the provided frontends, indexed by
+// listener name.
+func (s *Service) ensureACLs(ctx context.Context, frontends map[string]*lb.Frontend, pnID *string) error {
+	acls, err := s.acls(ctx, pnID)
+	if err != nil {
 		return err
 	}
 
+	for listener, frontend := range frontends {
+		if listener == ControlPlaneFrontendName {
+			if err := s.deleteLegacyMachineACLs(ctx, frontend.ID); err != nil {
+				return err
+			}
+		}
+
+		for _, acl := range acls {
+			if err := s.ensureACL(ctx, frontend.ID, listener, acl.name, acl.ips, acl.deny, acl.index); err != nil {
+				return fmt.Errorf("failed to set %s ACL on %s frontend: %w", acl.name, listener, err)
+			}
+		}
+	}
+
 	return nil
 }
 
-func (s *Service) Reconcile(ctx context.Context) error {
-	loadbalancer, err := s.getOrCreateLB(ctx, s.LoadBalancerZone())
+// reconcileZone reconciles the loadbalancer of the service zone.
+func (s *Service) reconcileZone(ctx context.Context) error {
+	s.status().Ready = false
+
+	loadbalancer, err := s.getOrCreateLB(ctx, s.zone)
 	if err != nil {
 		return err
 	}
@@ -294,6 +920,10 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return ErrLoadBalancerNotReady
 	}
 
+	if err := s.ensureType(ctx, loadbalancer); err != nil {
+		return err
+	}
+
 	var pnID *string
 
 	if s.HasPrivateNetwork() {
@@ -305,10 +935,18 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		pnID = &tmpPNID
 	}
 
-	if err := s.ensurePrivateNetwork(ctx, loadbalancer, pnID); err != nil {
+	privateIP, err := s.ensurePrivateNetwork(ctx, loadbalancer, pnID)
+	if err != nil {
 		return err
 	}
 
+	// The private IP may not be booked yet.
+	if pnID != nil && privateIP == nil {
+		return ErrLoadBalancerNotReady
+	}
+
+	s.status().PrivateIP = privateIP
+
 	backend, err := s.ensureBackend(ctx, loadbalancer)
 	if err != nil {
 		return fmt.Errorf("failed to ensure LoadBalancer backend: %w", err)
@@ -319,10 +957,31 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return fmt.Errorf("failed to ensure LoadBalancer frontend: %w", err)
 	}
 
-	if err := s.ensureACLs(ctx, frontend, pnID); err != nil {
+	frontends, err := s.ensureExtraListeners(ctx, loadbalancer)
+	if err != nil {
+		return fmt.Errorf("failed to ensure LoadBalancer extra listeners: %w", err)
+	}
+
+	// The ACLs of an existing frontend provided by the user are never managed.
+	if s.existingFrontendID() == nil {
+		frontends[ControlPlaneFrontendName] = frontend
+	}
+
+	if err := s.ensureACLs(ctx, frontends, pnID); err != nil {
 		return fmt.Errorf("failed to ensure LoadBalancer ACLs: %w", err)
 	}
 
+	if err := s.ReconcileBackendServers(ctx); err != nil {
+		return fmt.Errorf("failed to reconcile LoadBalancer backend servers: %w", err)
+	}
+
+	if s.HasPrivateLoadBalancer() {
+		s.setControlPlaneEndpoint(*privateIP, frontend.InboundPort)
+		s.status().Ready = true
+
+		return nil
+	}
+
 	var found bool
 	for _, lbIP := range loadbalancer.IP {
 		ip, err := netip.ParseAddr(lbIP.IPAddress)
@@ -330,23 +989,71 @@ func (s *Service) Reconcile(ctx context.Context) error {
 			return fmt.Errorf("failed to parse loadbalancer IP %q: %w", lbIP.IPAddress, err)
 		}
 
-		if ip.Is4() {
-			s.ScalewayCluster.Spec.ControlPlaneEndpoint.Host = lbIP.IPAddress
-			s.ScalewayCluster.Spec.ControlPlaneEndpoint.Port = frontend.InboundPort
+		if ipFamily(ip) == s.LoadBalancerEndpointIPFamily() {
+			s.setControlPlaneEndpoint(lbIP.IPAddress, frontend.InboundPort)
 			found = true
 			break
 		}
 	}
 
 	if !found {
-		return errors.New("loadbalancer has no IPs")
+		return fmt.Errorf("loadbalancer has no %s", s.LoadBalancerEndpointIPFamily())
+	}
+
+	s.status().Ready = true
+
+	return nil
+}
+
+// setControlPlaneEndpoint sets the control-plane endpoint of the cluster. The
+// FQDN of the DNS records is used instead of the host when DNS is configured.
+func (s *Service) setControlPlaneEndpoint(host string, port int32) {
+	if s.HasControlPlaneDNS() {
+		host = s.ControlPlaneDNSName()
 	}
 
+	s.ScalewayCluster.Spec.ControlPlaneEndpoint.Host = host
+	s.ScalewayCluster.Spec.ControlPlaneEndpoint.Port = port
+}
+
+// ipFamily returns the IP family of the IP.
+func ipFamily(ip netip.Addr) string {
+	if ip.Is4() || ip.Is4In6() {
+		return v1beta1.IPFamilyIPv4
+	}
+
+	return v1beta1.IPFamilyIPv6
+}
+
+// restoreZone restores the loadbalancer IDs of the service zone in the status
+// from the tags of the loadbalancer.
+func (s *Service) restoreZone(ctx context.Context) error {
+	if status := s.status(); status.LoadBalancerID != nil {
+		return nil
+	}
+
+	// An existing loadbalancer is always retrieved by its ID.
+	if s.existingLBID() != nil {
+		return nil
+	}
+
+	loadbalancer, err := s.ScalewayClient.FindLoadBalancerByTags(ctx, s.zone, s.Tags())
+	if err != nil {
+		if errors.Is(err, client.ErrNoItemFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	s.setStatusLB(loadbalancer)
+
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context) error {
-	loadbalancer, err := s.ScalewayClient.FindLoadBalancerByName(ctx, s.LoadBalancerZone(), s.Name())
+// deleteZone deletes the loadbalancer of the service zone.
+func (s *Service) deleteZone(ctx context.Context) error {
+	loadbalancer, err := s.getLB(ctx, s.zone)
 	if err != nil {
 		if errors.Is(err, client.ErrNoItemFound) {
 			return nil
@@ -355,6 +1062,11 @@ func (s *Service) Delete(ctx context.Context) error {
 		return err
 	}
 
+	// Only remove the resources that were created in an existing loadbalancer.
+	if s.existingLBID() != nil || s.status().ImportedLoadBalancer {
+		return s.cleanupLB(ctx, loadbalancer)
+	}
+
 	// Do not release IP if an IP was provided by the user.
 	releaseIP := !(s.ScalewayCluster.Spec.ControlPlaneLoadBalancer != nil &&
 		s.ScalewayCluster.Spec.ControlPlaneLoadBalancer.IP != nil)
@@ -363,9 +1075,104 @@ func (s *Service) Delete(ctx context.Context) error {
 		Zone:      loadbalancer.Zone,
 		LBID:      loadbalancer.ID,
 		ReleaseIP: releaseIP,
-	}); err != nil {
+	}); err != nil && !client.IsNotFoundError(err) {
 		return fmt.Errorf("failed to delete load balancer: %w", err)
 	}
 
 	return nil
 }
+
+// cleanupLB removes the resources created by the provider in an existing
+// loadbalancer: the ACLs, the extra listeners, the frontend and the backend
+// (unless they are also existing resources, in which case only the servers
+// added to the backend are removed) and the attachment to the managed Private
+// Network.
+func (s *Service) cleanupLB(ctx context.Context, loadbalancer *lb.LB) error {
+	status := s.status()
+
+	for name, aclID := range status.ACLIDs {
+		if err := s.ScalewayClient.LoadBalancer.DeleteACL(&lb.ZonedAPIDeleteACLRequest{
+			Zone:  loadbalancer.Zone,
+			ACLID: aclID,
+		}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to delete ACL %q: %w", name, err)
+		}
+
+		s.setStatusACLID(name, nil)
+	}
+
+	for name, listener := range status.Listeners {
+		if err := s.ScalewayClient.LoadBalancer.DeleteFrontend(&lb.ZonedAPIDeleteFrontendRequest{
+			Zone:       loadbalancer.Zone,
+			FrontendID: listener.FrontendID,
+		}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to delete frontend of listener %s: %w", name, err)
+		}
+
+		if err := s.ScalewayClient.LoadBalancer.DeleteBackend(&lb.ZonedAPIDeleteBackendRequest{
+			Zone:      loadbalancer.Zone,
+			BackendID: listener.BackendID,
+		}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to delete backend of listener %s: %w", name, err)
+		}
+
+		delete(status.Listeners, name)
+	}
+
+	if status.FrontendID != nil && !status.ImportedFrontend && s.existingFrontendID() == nil {
+		if err := s.ScalewayClient.LoadBalancer.DeleteFrontend(&lb.ZonedAPIDeleteFrontendRequest{
+			Zone:       loadbalancer.Zone,
+			FrontendID: *status.FrontendID,
+		}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to delete frontend: %w", err)
+		}
+
+		status.FrontendID = nil
+	}
+
+	// Only the servers that were added by the provider are removed from an
+	// existing backend.
+	if status.BackendID != nil && len(status.BackendServerIPs) > 0 && s.isImportedBackend(*status.BackendID) {
+		backend, err := s.ScalewayClient.LoadBalancer.GetBackend(&lb.ZonedAPIGetBackendRequest{
+			Zone:      loadbalancer.Zone,
+			BackendID: *status.BackendID,
+		}, scw.WithContext(ctx))
+		if err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to get backend: %w", err)
+		}
+
+		if err == nil {
+			if err := s.reconcileImportedBackendServers(ctx, backend, nil); err != nil {
+				return err
+			}
+		}
+
+		status.BackendServerIPs = nil
+	}
+
+	if status.BackendID != nil && !status.ImportedBackend && s.existingBackendID() == nil {
+		if err := s.ScalewayClient.LoadBalancer.DeleteBackend(&lb.ZonedAPIDeleteBackendRequest{
+			Zone:      loadbalancer.Zone,
+			BackendID: *status.BackendID,
+		}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to delete backend: %w", err)
+		}
+
+		status.BackendID = nil
+	}
+
+	// The managed Private Network must be detached before it can be deleted.
+	if s.ShouldManagePrivateNetwork() && s.NetworkStatus().PrivateNetworkID != nil {
+		pnID := *s.NetworkStatus().PrivateNetworkID
+
+		if err := s.ScalewayClient.LoadBalancer.DetachPrivateNetwork(&lb.ZonedAPIDetachPrivateNetworkRequest{
+			Zone:             loadbalancer.Zone,
+			LBID:             loadbalancer.ID,
+			PrivateNetworkID: pnID,
+		}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+			return fmt.Errorf("failed to detach private network: %w", err)
+		}
+	}
+
+	return nil
+}
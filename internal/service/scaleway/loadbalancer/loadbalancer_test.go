@@ -0,0 +1,664 @@
+package loadbalancer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	zonePath   = "/lb/v1/zones/fr-par-1"
+	lbID       = "11111111-1111-1111-1111-111111111111"
+	frontendID = "22222222-2222-2222-2222-222222222222"
+	backendID  = "33333333-3333-3333-3333-333333333333"
+	aclID      = "44444444-4444-4444-4444-444444444444"
+)
+
+func noContent(w http.ResponseWriter, _ *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func TestDeleteExistingLB(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        *infrastructurev1beta1.LoadBalancerSpec
+		wantDeleted []string
+		wantKept    []string
+	}{
+		{
+			name: "existing loadbalancer",
+			spec: &infrastructurev1beta1.LoadBalancerSpec{ID: scw.StringPtr(lbID)},
+			wantDeleted: []string{
+				"DELETE " + zonePath + "/acls/" + aclID,
+				"DELETE " + zonePath + "/frontends/" + frontendID,
+				"DELETE " + zonePath + "/backends/" + backendID,
+			},
+			wantKept: []string{
+				"DELETE " + zonePath + "/lbs/" + lbID,
+				"DELETE " + zonePath + "/backends/" + backendID + "/servers",
+			},
+		},
+		{
+			name: "existing loadbalancer, frontend and backend",
+			spec: &infrastructurev1beta1.LoadBalancerSpec{
+				ID:         scw.StringPtr(lbID),
+				FrontendID: scw.StringPtr(frontendID),
+				BackendID:  scw.StringPtr(backendID),
+			},
+			wantDeleted: []string{
+				"DELETE " + zonePath + "/acls/" + aclID,
+				"DELETE " + zonePath + "/backends/" + backendID + "/servers",
+			},
+			wantKept: []string{
+				"DELETE " + zonePath + "/lbs/" + lbID,
+				"DELETE " + zonePath + "/frontends/" + frontendID,
+				"DELETE " + zonePath + "/backends/" + backendID,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.NewAPI()
+			api.JSON("GET "+zonePath+"/lbs/"+lbID, &lb.LB{ID: lbID, Zone: scw.ZoneFrPar1})
+			api.Handle("DELETE "+zonePath+"/acls/"+aclID, noContent)
+			api.Handle("DELETE "+zonePath+"/frontends/"+frontendID, noContent)
+			api.Handle("DELETE "+zonePath+"/backends/"+backendID, noContent)
+			api.Handle("DELETE "+zonePath+"/lbs/"+lbID, noContent)
+			api.JSON("GET "+zonePath+"/backends/"+backendID, &lb.Backend{ID: backendID, Pool: []string{"10.0.0.1", "172.16.0.2"}})
+			api.JSON("DELETE "+zonePath+"/backends/"+backendID+"/servers", &lb.Backend{ID: backendID, Pool: []string{"10.0.0.1"}})
+
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, api),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrastructurev1beta1.ScalewayClusterSpec{
+						Region:                   "fr-par",
+						ControlPlaneLoadBalancer: tt.spec,
+					},
+					Status: infrastructurev1beta1.ScalewayClusterStatus{
+						LoadBalancer: &infrastructurev1beta1.LoadBalancerStatus{
+							LoadBalancerZoneStatus: infrastructurev1beta1.LoadBalancerZoneStatus{
+								LoadBalancerID:       scw.StringPtr(lbID),
+								FrontendID:           scw.StringPtr(frontendID),
+								BackendID:            scw.StringPtr(backendID),
+								ACLIDs:               map[string]string{"allowed-ranges": aclID},
+								ImportedLoadBalancer: true,
+								ImportedFrontend:     tt.spec.FrontendID != nil,
+								ImportedBackend:      tt.spec.BackendID != nil,
+								BackendServerIPs:     []string{"172.16.0.2"},
+							},
+						},
+					},
+				},
+			})
+
+			if err := s.Delete(context.Background()); err != nil {
+				t.Fatalf("Delete() error = %v", err)
+			}
+
+			for _, route := range tt.wantDeleted {
+				if !api.Called(route) {
+					t.Errorf("Delete() did not call %s", route)
+				}
+			}
+
+			for _, route := range tt.wantKept {
+				if api.Called(route) {
+					t.Errorf("Delete() called %s", route)
+				}
+			}
+		})
+	}
+}
+
+func TestGetOrCreateExistingLB(t *testing.T) {
+	api := fake.NewAPI()
+	api.JSON("GET "+zonePath+"/lbs/"+lbID, &lb.LB{ID: lbID, Name: "existing", Zone: scw.ZoneFrPar1})
+
+	s := NewService(&scope.Cluster{
+		ScalewayClient: fake.NewClient(t, api),
+		ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{ID: scw.StringPtr(lbID)},
+			},
+		},
+	})
+
+	if _, err := s.getOrCreateLB(context.Background(), scw.ZoneFrPar1); err != nil {
+		t.Fatalf("getOrCreateLB() error = %v", err)
+	}
+
+	if api.Called("PUT " + zonePath + "/lbs/" + lbID) {
+		t.Errorf("getOrCreateLB() updated the existing loadbalancer")
+	}
+
+	if !s.LoadBalancerStatus().ImportedLoadBalancer {
+		t.Errorf("getOrCreateLB() did not mark the loadbalancer as imported")
+	}
+}
+
+func TestEnsureACLsPrivateNetwork(t *testing.T) {
+	const pnID = "55555555-5555-5555-5555-555555555555"
+
+	tests := []struct {
+		name          string
+		allowedRanges []string
+		wantACLs      map[string][]string
+	}{
+		{
+			name:     "no allowed ranges",
+			wantACLs: map[string][]string{},
+		},
+		{
+			name:          "allowed ranges",
+			allowedRanges: []string{"1.2.3.4/32"},
+			wantACLs: map[string][]string{
+				"allowed-ranges":  {"1.2.3.4/32"},
+				"private-network": {"172.16.0.0/22"},
+				"deny-all":        {"0.0.0.0/0", "::/0"},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := infrastructurev1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.NewAPI()
+			for _, zone := range []string{"fr-par-1", "fr-par-2", "fr-par-3"} {
+				api.JSON("GET /vpc-gw/v1/zones/"+zone+"/gateways", &vpcgw.ListGatewaysResponse{})
+			}
+			api.JSON("GET /vpc/v2/regions/fr-par/private-networks/"+pnID, &vpc.PrivateNetwork{
+				ID:      pnID,
+				Region:  scw.RegionFrPar,
+				Subnets: []*vpc.Subnet{{Subnet: scw.IPNet{IPNet: net.IPNet{IP: net.IPv4(172, 16, 0, 0).To4(), Mask: net.CIDRMask(22, 32)}}}},
+			})
+			api.JSON("GET "+zonePath+"/frontends/"+frontendID+"/acls", &lb.ListACLResponse{})
+
+			acls := make(map[string][]string)
+			api.Handle("POST "+zonePath+"/frontends/"+frontendID+"/acls", func(w http.ResponseWriter, r *http.Request) {
+				var req lb.ZonedAPICreateACLRequest
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatal(err)
+				}
+
+				for _, subnet := range req.Match.IPSubnet {
+					acls[req.Name] = append(acls[req.Name], *subnet)
+				}
+
+				fake.WriteJSON(w, http.StatusOK, &lb.ACL{ID: aclID, Name: req.Name})
+			})
+
+			s := NewService(&scope.Cluster{
+				Client:         crfake.NewClientBuilder().WithScheme(scheme).Build(),
+				ScalewayClient: fake.NewClient(t, api),
+				Cluster: &v1beta1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+				},
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Spec: infrastructurev1beta1.ScalewayClusterSpec{
+						Region:                   "fr-par",
+						Network:                  &infrastructurev1beta1.NetworkSpec{PrivateNetwork: &infrastructurev1beta1.PrivateNetworkSpec{Enabled: true}},
+						ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{AllowedRanges: tt.allowedRanges},
+					},
+				},
+			})
+
+			if err := s.ensureACLs(context.Background(), map[string]*lb.Frontend{ControlPlaneFrontendName: {ID: frontendID}}, scw.StringPtr(pnID)); err != nil {
+				t.Fatalf("ensureACLs() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(acls, tt.wantACLs) {
+				t.Errorf("ensureACLs() created %v, want %v", acls, tt.wantACLs)
+			}
+		})
+	}
+}
+
+func TestEnsureType(t *testing.T) {
+	tests := []struct {
+		name         string
+		spec         *infrastructurev1beta1.LoadBalancerSpec
+		currentType  string
+		wantErr      error
+		wantMigrated bool
+	}{
+		{
+			name:        "same type",
+			spec:        &infrastructurev1beta1.LoadBalancerSpec{Type: scw.StringPtr("LB-GP-M")},
+			currentType: "lb-gp-m",
+		},
+		{
+			name:        "default type",
+			currentType: "lb-s",
+		},
+		{
+			name:         "different type",
+			spec:         &infrastructurev1beta1.LoadBalancerSpec{Type: scw.StringPtr("LB-GP-M")},
+			currentType:  "lb-s",
+			wantErr:      ErrLoadBalancerNotReady,
+			wantMigrated: true,
+		},
+		{
+			name: "existing loadbalancer",
+			spec: &infrastructurev1beta1.LoadBalancerSpec{
+				ID:   scw.StringPtr(lbID),
+				Type: scw.StringPtr("LB-GP-M"),
+			},
+			currentType: "lb-s",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.NewAPI()
+			api.JSON("POST "+zonePath+"/lbs/"+lbID+"/migrate", &lb.LB{ID: lbID, Zone: scw.ZoneFrPar1, Type: "lb-gp-m"})
+
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, api),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrastructurev1beta1.ScalewayClusterSpec{
+						Region:                   "fr-par",
+						ControlPlaneLoadBalancer: tt.spec,
+					},
+				},
+			})
+
+			err := s.ensureType(context.Background(), &lb.LB{ID: lbID, Zone: scw.ZoneFrPar1, Type: tt.currentType})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ensureType() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if migrated := api.Called("POST " + zonePath + "/lbs/" + lbID + "/migrate"); migrated != tt.wantMigrated {
+				t.Errorf("loadbalancer migrated = %v, want %v", migrated, tt.wantMigrated)
+			}
+
+			if tt.wantMigrated && *s.LoadBalancerStatus().Type != "lb-gp-m" {
+				t.Errorf("status type = %s, want lb-gp-m", *s.LoadBalancerStatus().Type)
+			}
+		})
+	}
+}
+
+func TestHealthCheckMatches(t *testing.T) {
+	https := infrastructurev1beta1.LoadBalancerHealthCheckProtocolHTTPS
+
+	tests := []struct {
+		name     string
+		current  *lb.HealthCheck
+		expected *lb.HealthCheck
+		want     bool
+	}{
+		{
+			name:     "no current health check",
+			expected: toHealthCheck(nil, 6443),
+		},
+		{
+			name:     "default TCP health check",
+			current:  toHealthCheck(nil, 6443),
+			expected: toHealthCheck(nil, 6443),
+			want:     true,
+		},
+		{
+			name:     "different port",
+			current:  toHealthCheck(nil, 6444),
+			expected: toHealthCheck(nil, 6443),
+		},
+		{
+			name:     "different max retries",
+			current:  toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{MaxRetries: scw.Int32Ptr(3)}, 6443),
+			expected: toHealthCheck(nil, 6443),
+		},
+		{
+			name: "interval and timeout are ignored when not expected",
+			current: toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{
+				Interval: &metav1.Duration{Duration: 3 * time.Second},
+				Timeout:  &metav1.Duration{Duration: time.Second},
+			}, 6443),
+			expected: toHealthCheck(nil, 6443),
+			want:     true,
+		},
+		{
+			name:     "different interval",
+			current:  toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Interval: &metav1.Duration{Duration: 3 * time.Second}}, 6443),
+			expected: toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Interval: &metav1.Duration{Duration: 5 * time.Second}}, 6443),
+		},
+		{
+			name:     "missing timeout",
+			current:  toHealthCheck(nil, 6443),
+			expected: toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Timeout: &metav1.Duration{Duration: time.Second}}, 6443),
+		},
+		{
+			name:     "TCP instead of HTTPS",
+			current:  toHealthCheck(nil, 6443),
+			expected: toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Protocol: &https}, 6443),
+		},
+		{
+			name:     "HTTPS instead of TCP",
+			current:  toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Protocol: &https}, 6443),
+			expected: toHealthCheck(nil, 6443),
+		},
+		{
+			name:     "same HTTPS health check",
+			current:  toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Protocol: &https}, 6443),
+			expected: toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Protocol: &https}, 6443),
+			want:     true,
+		},
+		{
+			name:     "different HTTPS path",
+			current:  toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Protocol: &https}, 6443),
+			expected: toHealthCheck(&infrastructurev1beta1.LoadBalancerHealthCheck{Protocol: &https, Path: scw.StringPtr("/livez")}, 6443),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := healthCheckMatches(tt.current, tt.expected); got != tt.want {
+				t.Errorf("healthCheckMatches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileImportedBackendServers(t *testing.T) {
+	tests := []struct {
+		name        string
+		pool        []string
+		tracked     []string
+		ips         []string
+		wantAdded   []string
+		wantRemoved []string
+		wantTracked []string
+	}{
+		{
+			name:        "new control-plane IP",
+			pool:        []string{"10.0.0.1"},
+			ips:         []string{"172.16.0.2"},
+			wantAdded:   []string{"172.16.0.2"},
+			wantTracked: []string{"172.16.0.2"},
+		},
+		{
+			name:        "removed control-plane IP",
+			pool:        []string{"10.0.0.1", "172.16.0.2", "172.16.0.3"},
+			tracked:     []string{"172.16.0.2", "172.16.0.3"},
+			ips:         []string{"172.16.0.2"},
+			wantRemoved: []string{"172.16.0.3"},
+			wantTracked: []string{"172.16.0.2"},
+		},
+		{
+			name: "control-plane IP added by the user",
+			pool: []string{"10.0.0.1", "172.16.0.2"},
+			ips:  []string{"172.16.0.2"},
+		},
+		{
+			name:        "cleanup",
+			pool:        []string{"10.0.0.1", "172.16.0.2"},
+			tracked:     []string{"172.16.0.2"},
+			wantRemoved: []string{"172.16.0.2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added, removed []string
+
+			serverIPs := func(r *http.Request) []string {
+				var req struct {
+					ServerIP []string `json:"server_ip"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Fatal(err)
+				}
+
+				return req.ServerIP
+			}
+
+			api := fake.NewAPI()
+			api.Handle("POST "+zonePath+"/backends/"+backendID+"/servers", func(w http.ResponseWriter, r *http.Request) {
+				added = serverIPs(r)
+				fake.WriteJSON(w, http.StatusOK, &lb.Backend{ID: backendID})
+			})
+			api.Handle("DELETE "+zonePath+"/backends/"+backendID+"/servers", func(w http.ResponseWriter, r *http.Request) {
+				removed = serverIPs(r)
+				fake.WriteJSON(w, http.StatusOK, &lb.Backend{ID: backendID})
+			})
+
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, api),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       infrastructurev1beta1.ScalewayClusterSpec{Region: "fr-par"},
+					Status: infrastructurev1beta1.ScalewayClusterStatus{
+						LoadBalancer: &infrastructurev1beta1.LoadBalancerStatus{
+							LoadBalancerZoneStatus: infrastructurev1beta1.LoadBalancerZoneStatus{
+								BackendID:        scw.StringPtr(backendID),
+								ImportedBackend:  true,
+								BackendServerIPs: tt.tracked,
+							},
+						},
+					},
+				},
+			})
+
+			backend := &lb.Backend{ID: backendID, Pool: tt.pool}
+			if err := s.reconcileImportedBackendServers(context.Background(), backend, tt.ips); err != nil {
+				t.Fatalf("reconcileImportedBackendServers() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(added, tt.wantAdded) {
+				t.Errorf("added servers = %v, want %v", added, tt.wantAdded)
+			}
+
+			if !reflect.DeepEqual(removed, tt.wantRemoved) {
+				t.Errorf("removed servers = %v, want %v", removed, tt.wantRemoved)
+			}
+
+			if got := s.LoadBalancerStatus().BackendServerIPs; !reflect.DeepEqual(got, tt.wantTracked) {
+				t.Errorf("tracked servers = %v, want %v", got, tt.wantTracked)
+			}
+		})
+	}
+}
+
+func TestIsImportedBackend(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		spec        *infrastructurev1beta1.LoadBalancerSpec
+		status      infrastructurev1beta1.LoadBalancerZoneStatus
+		backendID   string
+		want        bool
+	}{
+		{
+			name:      "backend created by the provider",
+			status:    infrastructurev1beta1.LoadBalancerZoneStatus{BackendID: scw.StringPtr(backendID)},
+			backendID: backendID,
+		},
+		{
+			name: "existing backend",
+			spec: &infrastructurev1beta1.LoadBalancerSpec{
+				ID:        scw.StringPtr(lbID),
+				BackendID: scw.StringPtr(backendID),
+			},
+			status:    infrastructurev1beta1.LoadBalancerZoneStatus{BackendID: scw.StringPtr(backendID)},
+			backendID: backendID,
+			want:      true,
+		},
+		{
+			name:      "imported backend",
+			status:    infrastructurev1beta1.LoadBalancerZoneStatus{BackendID: scw.StringPtr(backendID), ImportedBackend: true},
+			backendID: backendID,
+			want:      true,
+		},
+		{
+			name:        "backend of an externally managed cluster",
+			annotations: map[string]string{v1beta1.ManagedByAnnotation: "external"},
+			status:      infrastructurev1beta1.LoadBalancerZoneStatus{BackendID: scw.StringPtr(backendID)},
+			backendID:   backendID,
+			want:        true,
+		},
+		{
+			name:      "extra listener backend",
+			status:    infrastructurev1beta1.LoadBalancerZoneStatus{BackendID: scw.StringPtr(backendID), ImportedBackend: true},
+			backendID: "66666666-6666-6666-6666-666666666666",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, fake.NewAPI()),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Annotations: tt.annotations},
+					Spec: infrastructurev1beta1.ScalewayClusterSpec{
+						Region:                   "fr-par",
+						ControlPlaneLoadBalancer: tt.spec,
+					},
+					Status: infrastructurev1beta1.ScalewayClusterStatus{
+						LoadBalancer: &infrastructurev1beta1.LoadBalancerStatus{LoadBalancerZoneStatus: tt.status},
+					},
+				},
+			})
+
+			if got := s.isImportedBackend(tt.backendID); got != tt.want {
+				t.Errorf("isImportedBackend() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDisabledLB(t *testing.T) {
+	// The fake API has no route: any request to the API fails.
+	s := NewService(&scope.Cluster{
+		ScalewayClient: fake.NewClient(t, fake.NewAPI()),
+		ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region:                   "fr-par",
+				ControlPlaneEndpoint:     v1beta1.APIEndpoint{Host: "172.16.0.10", Port: 6443},
+				ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{Disabled: scw.BoolPtr(true)},
+			},
+		},
+	})
+
+	if err := s.Reconcile(context.Background()); err != nil {
+		t.Errorf("Reconcile() error = %v", err)
+	}
+
+	if err := s.Delete(context.Background()); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+
+	if s.ScalewayCluster.Status.LoadBalancer != nil {
+		t.Errorf("status of a disabled loadbalancer = %v, want nil", s.ScalewayCluster.Status.LoadBalancer)
+	}
+}
+
+func TestBackendServerIPs(t *testing.T) {
+	machine := func(name, private, public string) infrastructurev1beta1.ScalewayMachine {
+		return infrastructurev1beta1.ScalewayMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: infrastructurev1beta1.ScalewayMachineStatus{
+				Addresses: []v1beta1.MachineAddress{
+					{Type: v1beta1.MachineInternalIP, Address: private},
+					{Type: v1beta1.MachineExternalIP, Address: public},
+				},
+			},
+		}
+	}
+
+	// cp-0 and cp-1 were attached to the Private Network after they were
+	// added to the pool, cp-2 was already switched to its private IP.
+	machines := []infrastructurev1beta1.ScalewayMachine{
+		machine("cp-0", "172.16.0.2", "51.15.0.2"),
+		machine("cp-1", "172.16.0.3", "51.15.0.3"),
+		machine("cp-2", "172.16.0.4", "51.15.0.4"),
+	}
+
+	tests := []struct {
+		name        string
+		health      lb.BackendServerStatsHealthCheckStatus
+		wantIPs     []string
+		wantPending []string
+	}{
+		{
+			name:        "private servers are healthy",
+			health:      lb.BackendServerStatsHealthCheckStatusPassed,
+			wantIPs:     []string{"172.16.0.2", "172.16.0.4", "51.15.0.3"},
+			wantPending: []string{"cp-1"},
+		},
+		{
+			name:        "private servers are not healthy",
+			health:      lb.BackendServerStatsHealthCheckStatusFailed,
+			wantIPs:     []string{"172.16.0.4", "51.15.0.2", "51.15.0.3"},
+			wantPending: []string{"cp-0", "cp-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.NewAPI()
+			api.JSON("GET "+zonePath+"/lbs/"+lbID+"/backend-stats", &lb.ListBackendStatsResponse{
+				BackendServersStats: []*lb.BackendServerStats{
+					{IP: "172.16.0.4", LastHealthCheckStatus: tt.health},
+				},
+				TotalCount: 1,
+			})
+
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, api),
+				ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: infrastructurev1beta1.ScalewayClusterSpec{
+						Region:                   "fr-par",
+						ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{Zone: scw.StringPtr("fr-par-1")},
+					},
+				},
+			})
+
+			backend := &lb.Backend{
+				ID:   backendID,
+				LB:   &lb.LB{ID: lbID},
+				Pool: []string{"51.15.0.2", "51.15.0.3", "172.16.0.4"},
+			}
+
+			ips, pending, err := s.backendServerIPs(context.Background(), backend, machines)
+			if err != nil {
+				t.Fatalf("backendServerIPs() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(ips, tt.wantIPs) {
+				t.Errorf("backendServerIPs() IPs = %v, want %v", ips, tt.wantIPs)
+			}
+
+			if !reflect.DeepEqual(pending, tt.wantPending) {
+				t.Errorf("backendServerIPs() pending = %v, want %v", pending, tt.wantPending)
+			}
+		})
+	}
+}
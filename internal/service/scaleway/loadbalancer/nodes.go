@@ -0,0 +1,198 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+const (
+	// nodesACLBaseName is the name of the ACL that allows the public IPs of the
+	// nodes. Additional chunks are suffixed by their position (nodes-1, ...).
+	nodesACLBaseName = "nodes"
+
+	// nodesACLIndex is the index of the nodes ACLs. They are evaluated after
+	// the allowed-ranges (1) and public-gateway (2) ACLs and before the
+	// deny-all (4) ACL.
+	nodesACLIndex = 3
+
+	// maxNodesACLIPs is the maximum number of IPs in a single nodes ACL.
+	maxNodesACLIPs = 50
+)
+
+// nodesACLName returns the name of the nodes ACL chunk at position i.
+func nodesACLName(i int) string {
+	if i == 0 {
+		return nodesACLBaseName
+	}
+
+	return fmt.Sprintf("%s-%d", nodesACLBaseName, i)
+}
+
+// nodesACLChunk returns the position of the nodes ACL chunk with the provided
+// name, or false if name is not the name of a nodes ACL.
+func nodesACLChunk(name string) (int, bool) {
+	if name == nodesACLBaseName {
+		return 0, true
+	}
+
+	suffix, ok := strings.CutPrefix(name, nodesACLBaseName+"-")
+	if !ok {
+		return 0, false
+	}
+
+	i, err := strconv.Atoi(suffix)
+	if err != nil || i <= 0 {
+		return 0, false
+	}
+
+	return i, true
+}
+
+// nodesACLs returns the ACLs that allow the public IPs of all the nodes of the
+// cluster. The IPs are sorted and split into chunks of maxNodesACLIPs IPs.
+// Chunks that are no longer needed are returned without IPs so that they
+// are deleted.
+func (s *Service) nodesACLs(ctx context.Context) ([]loadBalancerACL, error) {
+	ips, err := s.nodePublicIPs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	acls := []loadBalancerACL{{name: nodesACLName(0), index: nodesACLIndex}}
+
+	for i := 0; i*maxNodesACLIPs < len(ips); i++ {
+		chunk := ips[i*maxNodesACLIPs : min((i+1)*maxNodesACLIPs, len(ips))]
+
+		if i == 0 {
+			acls[0].ips = chunk
+			continue
+		}
+
+		acls = append(acls, loadBalancerACL{name: nodesACLName(i), ips: chunk, index: nodesACLIndex})
+	}
+
+	// Find stale chunks in the status.
+	var stale []int
+	for key := range s.status().ACLIDs {
+		if i, ok := nodesACLChunk(key[strings.LastIndex(key, "/")+1:]); ok && i >= len(acls) && !slices.Contains(stale, i) {
+			stale = append(stale, i)
+		}
+	}
+
+	slices.Sort(stale)
+
+	for _, i := range stale {
+		acls = append(acls, loadBalancerACL{name: nodesACLName(i), index: nodesACLIndex})
+	}
+
+	return acls, nil
+}
+
+// nodePublicIPs returns the sorted public IPv4 and IPv6 addresses of the
+// machines of the cluster.
+func (s *Service) nodePublicIPs(ctx context.Context) ([]string, error) {
+	machines, err := s.Machines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ips := []string{}
+
+	for _, machine := range machines {
+		for _, address := range machine.Status.Addresses {
+			if address.Type == v1beta1.MachineExternalIP && !slices.Contains(ips, address.Address) {
+				ips = append(ips, address.Address)
+			}
+		}
+	}
+
+	slices.Sort(ips)
+
+	return ips, nil
+}
+
+// deleteLegacyMachineACLs deletes the ACLs that were created for each machine
+// on the control-plane frontend by previous versions of the provider. The
+// public IPs of the nodes are now allowed by the nodes ACLs.
+func (s *Service) deleteLegacyMachineACLs(ctx context.Context, frontendID string) error {
+	machines, err := s.Machines(ctx)
+	if err != nil {
+		return err
+	}
+
+	names := make(map[string]struct{}, len(machines))
+	for _, machine := range machines {
+		names[scope.MachineName(machine.Name)] = struct{}{}
+	}
+
+	acls, err := s.ScalewayClient.LoadBalancer.ListACLs(&lb.ZonedAPIListACLsRequest{
+		Zone:       s.zone,
+		FrontendID: frontendID,
+	}, scw.WithAllPages(), scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to list ACLs: %w", err)
+	}
+
+	for _, acl := range acls.ACLs {
+		if _, ok := names[acl.Name]; !ok {
+			continue
+		}
+
+		if err := s.ScalewayClient.LoadBalancer.DeleteACL(&lb.ZonedAPIDeleteACLRequest{
+			Zone:  s.zone,
+			ACLID: acl.ID,
+		}, scw.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to delete ACL %s: %w", acl.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ReconcileNodesACLs ensures the nodes ACLs are set on the frontends that are
+// known in the status. It is used when the loadbalancer is not reconciled by
+// the Reconcile method, for example when the cluster is externally managed.
+func (s *Service) ReconcileNodesACLs(ctx context.Context) error {
+	acls, err := s.nodesACLs(ctx)
+	if err != nil {
+		return err
+	}
+
+	status := s.status()
+
+	// The ACLs of an existing frontend provided by the user are never managed.
+	frontendIDs := make(map[string]string, len(status.Listeners)+1)
+	if status.FrontendID != nil && !status.ImportedFrontend {
+		frontendIDs[ControlPlaneFrontendName] = *status.FrontendID
+	}
+
+	for name, listener := range status.Listeners {
+		if listener.FrontendID != "" {
+			frontendIDs[name] = listener.FrontendID
+		}
+	}
+
+	if frontendID, ok := frontendIDs[ControlPlaneFrontendName]; ok {
+		if err := s.deleteLegacyMachineACLs(ctx, frontendID); err != nil {
+			return err
+		}
+	}
+
+	for listener, frontendID := range frontendIDs {
+		for _, acl := range acls {
+			if err := s.ensureACL(ctx, frontendID, listener, acl.name, acl.ips, acl.deny, acl.index); err != nil {
+				return fmt.Errorf("failed to set %s ACL on %s frontend: %w", acl.name, listener, err)
+			}
+		}
+	}
+
+	return nil
+}
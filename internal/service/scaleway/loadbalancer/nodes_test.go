@@ -0,0 +1,165 @@
+package loadbalancer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNodesACLChunk(t *testing.T) {
+	tests := []struct {
+		name   string
+		want   int
+		wantOK bool
+	}{
+		{name: "nodes", want: 0, wantOK: true},
+		{name: "nodes-1", want: 1, wantOK: true},
+		{name: "nodes-12", want: 12, wantOK: true},
+		{name: "nodes-0"},
+		{name: "nodes--1"},
+		{name: "nodes-a"},
+		{name: "nodes-"},
+		{name: "deny-all"},
+		{name: "caps-nodes-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := nodesACLChunk(tt.name)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("nodesACLChunk() = %d, %v, want %d, %v", got, ok, tt.want, tt.wantOK)
+			}
+
+			if ok && nodesACLName(got) != tt.name {
+				t.Errorf("nodesACLName(%d) = %s, want %s", got, nodesACLName(got), tt.name)
+			}
+		})
+	}
+}
+
+// newTestMachines returns ScalewayMachines of the "test" cluster with a total
+// of count distinct public IPs.
+func newTestMachines(count int) []client.Object {
+	machines := make([]client.Object, 0, count)
+
+	for i := 0; i < count; i++ {
+		machines = append(machines, &infrastructurev1beta1.ScalewayMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("machine-%d", i),
+				Namespace: "default",
+				Labels:    map[string]string{v1beta1.ClusterNameLabel: "test"},
+			},
+			Status: infrastructurev1beta1.ScalewayMachineStatus{
+				Addresses: []v1beta1.MachineAddress{
+					{Type: v1beta1.MachineExternalIP, Address: fmt.Sprintf("51.15.%d.%d", i/256, i%256)},
+					{Type: v1beta1.MachineInternalIP, Address: fmt.Sprintf("172.16.%d.%d", i/256, i%256)},
+				},
+			},
+		})
+	}
+
+	return machines
+}
+
+func TestNodesACLs(t *testing.T) {
+	tests := []struct {
+		name     string
+		machines int
+		aclIDs   map[string]string
+		wantIPs  map[string]int
+	}{
+		{
+			name:    "no machines",
+			wantIPs: map[string]int{"nodes": 0},
+		},
+		{
+			name:     "single chunk",
+			machines: 50,
+			wantIPs:  map[string]int{"nodes": 50},
+		},
+		{
+			name:     "multiple chunks",
+			machines: 120,
+			wantIPs:  map[string]int{"nodes": 50, "nodes-1": 50, "nodes-2": 20},
+		},
+		{
+			name:     "stale chunks",
+			machines: 51,
+			aclIDs: map[string]string{
+				"nodes":         "1",
+				"nodes-1":       "2",
+				"nodes-2":       "3",
+				"talos/nodes-3": "4",
+				"deny-all":      "5",
+			},
+			wantIPs: map[string]int{"nodes": 50, "nodes-1": 1, "nodes-2": 0, "nodes-3": 0},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := infrastructurev1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				Cluster: &scope.Cluster{
+					Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(newTestMachines(tt.machines)...).Build(),
+					Cluster: &v1beta1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					},
+					ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+						ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+						Spec: infrastructurev1beta1.ScalewayClusterSpec{
+							Region: "fr-par",
+							ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{
+								Zone: scw.StringPtr(scw.ZoneFrPar1.String()),
+							},
+						},
+						Status: infrastructurev1beta1.ScalewayClusterStatus{
+							LoadBalancer: &infrastructurev1beta1.LoadBalancerStatus{
+								LoadBalancerZoneStatus: infrastructurev1beta1.LoadBalancerZoneStatus{
+									ACLIDs: tt.aclIDs,
+								},
+							},
+						},
+					},
+				},
+				zone: scw.ZoneFrPar1,
+			}
+
+			acls, err := s.nodesACLs(context.Background())
+			if err != nil {
+				t.Fatalf("nodesACLs() error = %v", err)
+			}
+
+			if len(acls) != len(tt.wantIPs) {
+				t.Fatalf("nodesACLs() returned %d ACLs, want %d", len(acls), len(tt.wantIPs))
+			}
+
+			for i, acl := range acls {
+				if acl.name != nodesACLName(i) {
+					t.Errorf("ACL %d is named %s, want %s", i, acl.name, nodesACLName(i))
+				}
+
+				if len(acl.ips) != tt.wantIPs[acl.name] {
+					t.Errorf("ACL %s has %d IPs, want %d", acl.name, len(acl.ips), tt.wantIPs[acl.name])
+				}
+
+				if acl.index != nodesACLIndex || acl.deny {
+					t.Errorf("ACL %s has index %d and deny %v", acl.name, acl.index, acl.deny)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package loadbalancer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// ErrExtraZoneNotReady is returned when the loadbalancer of the main zone is
+// ready but the loadbalancer of at least one extra zone is not.
+var ErrExtraZoneNotReady = errors.New("loadbalancer of an extra zone is not ready")
+
+// Reconcile reconciles the loadbalancers of all the zones. An error is returned
+// immediately if the main loadbalancer cannot be reconciled. The extra zones
+// are reconciled independently so that an unavailable zone does not prevent
+// the others from being reconciled: ErrExtraZoneNotReady is returned if at
+// least one of them is not ready.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if !s.HasLoadBalancer() {
+		return nil
+	}
+
+	zones := s.LoadBalancerZones()
+
+	if err := s.forZone(zones[0]).reconcileZone(ctx); err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, zone := range zones[1:] {
+		if err := s.forZone(zone).reconcileZone(ctx); err != nil {
+			log.FromContext(ctx).Info("failed to reconcile loadbalancer", "zone", zone, "err", err)
+			errs = append(errs, fmt.Errorf("%s: %w", zone, err))
+		}
+	}
+
+	if err := s.pruneExtraZones(ctx); err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrExtraZoneNotReady, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// pruneExtraZones deletes the loadbalancers of the extra zones that were
+// removed from the spec.
+func (s *Service) pruneExtraZones(ctx context.Context) error {
+	zones := s.LoadBalancerZones()
+
+	for _, zone := range s.statusExtraZones() {
+		if slices.Contains(zones, zone) {
+			continue
+		}
+
+		if err := s.forZone(zone).deleteZone(ctx); err != nil {
+			return fmt.Errorf("failed to delete loadbalancer in zone %s: %w", zone, err)
+		}
+
+		status := s.LoadBalancerStatus()
+		status.ExtraZones = slices.DeleteFunc(status.ExtraZones, func(z v1beta1.LoadBalancerZoneStatus) bool {
+			return z.Zone == zone.String()
+		})
+	}
+
+	return nil
+}
+
+// statusExtraZones returns the extra zones that are known in the status.
+func (s *Service) statusExtraZones() []scw.Zone {
+	status := s.LoadBalancerStatus()
+
+	zones := make([]scw.Zone, 0, len(status.ExtraZones))
+	for _, z := range status.ExtraZones {
+		zones = append(zones, scw.Zone(z.Zone))
+	}
+
+	return zones
+}
+
+// Restore restores the loadbalancer IDs in the status from the tags of the
+// loadbalancers. This is needed when the status was lost, for example after
+// the ScalewayCluster was moved to another management cluster. The frontends,
+// backends and ACLs are then discovered by name during the next reconciliation.
+func (s *Service) Restore(ctx context.Context) error {
+	if !s.HasLoadBalancer() {
+		return nil
+	}
+
+	for _, zone := range s.LoadBalancerZones() {
+		if err := s.forZone(zone).restoreZone(ctx); err != nil {
+			return fmt.Errorf("failed to restore loadbalancer in zone %s: %w", zone, err)
+		}
+	}
+
+	return nil
+}
+
+// Delete deletes the loadbalancers of all the zones, including the zones that
+// were removed from the spec but are still known in the status.
+func (s *Service) Delete(ctx context.Context) error {
+	if !s.HasLoadBalancer() {
+		return nil
+	}
+
+	zones := s.LoadBalancerZones()
+	for _, zone := range s.statusExtraZones() {
+		if !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+
+	for _, zone := range zones {
+		if err := s.forZone(zone).deleteZone(ctx); err != nil {
+			return fmt.Errorf("failed to delete loadbalancer in zone %s: %w", zone, err)
+		}
+	}
+
+	return nil
+}
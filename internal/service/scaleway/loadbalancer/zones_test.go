@@ -0,0 +1,87 @@
+package loadbalancer
+
+import (
+	"context"
+	"testing"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	"github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestZoneStatus(t *testing.T) {
+	s := NewService(&scope.Cluster{
+		ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region: "fr-par",
+				ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{
+					Zone:       scw.StringPtr(scw.ZoneFrPar1.String()),
+					ExtraZones: []string{scw.ZoneFrPar2.String()},
+				},
+			},
+		},
+	})
+
+	mainStatus := s.status()
+	if mainStatus != &s.LoadBalancerStatus().LoadBalancerZoneStatus {
+		t.Errorf("status() of the main zone is not the inline status")
+	}
+
+	if mainStatus.Zone != scw.ZoneFrPar1.String() {
+		t.Errorf("status() zone = %s, want %s", mainStatus.Zone, scw.ZoneFrPar1)
+	}
+
+	extra := s.forZone(scw.ZoneFrPar2).status()
+	extra.LoadBalancerID = scw.StringPtr(lbID)
+
+	if got := s.forZone(scw.ZoneFrPar2).status(); got.LoadBalancerID == nil || *got.LoadBalancerID != lbID {
+		t.Errorf("status() of the extra zone was not persisted")
+	}
+
+	if got := s.LoadBalancerStatus().ExtraZones; len(got) != 1 || got[0].Zone != scw.ZoneFrPar2.String() {
+		t.Errorf("extra zones status = %v, want a single %s zone", got, scw.ZoneFrPar2)
+	}
+}
+
+func TestDeleteRemovedExtraZone(t *testing.T) {
+	const extraZonePath = "/lb/v1/zones/fr-par-2"
+
+	api := fake.NewAPI()
+	api.JSON("GET "+zonePath+"/lbs", &lb.ListLBsResponse{})
+	api.JSON("GET "+extraZonePath+"/lbs/"+lbID, &lb.LB{ID: lbID, Name: "caps-test", Zone: scw.ZoneFrPar2})
+	api.Handle("DELETE "+extraZonePath+"/lbs/"+lbID, noContent)
+
+	// The fr-par-2 zone was removed from the spec but its loadbalancer is
+	// still known in the status.
+	s := NewService(&scope.Cluster{
+		ScalewayClient: fake.NewClient(t, api),
+		ScalewayCluster: &infrastructurev1beta1.ScalewayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: infrastructurev1beta1.ScalewayClusterSpec{
+				Region: "fr-par",
+				ControlPlaneLoadBalancer: &infrastructurev1beta1.LoadBalancerSpec{
+					Zone: scw.StringPtr(scw.ZoneFrPar1.String()),
+				},
+			},
+			Status: infrastructurev1beta1.ScalewayClusterStatus{
+				LoadBalancer: &infrastructurev1beta1.LoadBalancerStatus{
+					ExtraZones: []infrastructurev1beta1.LoadBalancerZoneStatus{
+						{Zone: scw.ZoneFrPar2.String(), LoadBalancerID: scw.StringPtr(lbID)},
+					},
+				},
+			},
+		},
+	})
+
+	if err := s.Delete(context.Background()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if !api.Called("DELETE " + extraZonePath + "/lbs/" + lbID) {
+		t.Errorf("Delete() did not delete the loadbalancer of the removed extra zone")
+	}
+}
@@ -137,10 +137,11 @@ func (s *Service) ensureSecurityGroups(ctx context.Context, securityGroups []v1b
 		return fmt.Errorf("failed to list security groups: %w", err)
 	}
 
-	// Remove security groups that should not exist.
+	// Remove security groups that should not exist. Security groups that
+	// were replaced by existing security groups are also removed.
 	for _, existingSG := range existingSGs.SecurityGroups {
 		if !slices.ContainsFunc(securityGroups, func(sg v1beta1.SecurityGroup) bool {
-			return s.SecurityGroupName(sg.Name) == existingSG.Name
+			return s.SecurityGroupName(sg.Name) == existingSG.Name && len(sg.IDs) == 0
 		}) {
 			if err := s.ScalewayClient.Instance.DeleteSecurityGroup(&instance.DeleteSecurityGroupRequest{
 				Zone:            existingSG.Zone,
@@ -152,13 +153,42 @@ func (s *Service) ensureSecurityGroups(ctx context.Context, securityGroups []v1b
 		}
 	}
 
+	// Forget security groups that should not exist.
+	if s.ScalewayCluster.Status.Network != nil {
+		for name := range s.ScalewayCluster.Status.Network.SecurityGroups {
+			if !slices.ContainsFunc(securityGroups, func(sg v1beta1.SecurityGroup) bool {
+				return sg.Name == name
+			}) {
+				delete(s.ScalewayCluster.Status.Network.SecurityGroups, name)
+			}
+		}
+	}
+
 	// Create/Update security groups in all zones.
 	for _, sg := range securityGroups {
+		// Existing security groups are not managed.
+		if len(sg.IDs) > 0 {
+			if err := s.useExistingSecurityGroups(ctx, sg); err != nil {
+				return err
+			}
+
+			continue
+		}
+
 		for _, zone := range s.Zones(s.ScalewayClient.Instance.Zones()) {
-			// Check if the SG exists.
-			existingSGIndex := slices.IndexFunc(existingSGs.SecurityGroups, func(existingSG *instance.SecurityGroup) bool {
-				return existingSG.Name == s.SecurityGroupName(sg.Name) && existingSG.Zone == zone
-			})
+			// Check if the SG exists, by ID first and then by name.
+			existingSGIndex := -1
+			if sgID, ok := s.SecurityGroupID(sg.Name, zone); ok {
+				existingSGIndex = slices.IndexFunc(existingSGs.SecurityGroups, func(existingSG *instance.SecurityGroup) bool {
+					return existingSG.ID == sgID
+				})
+			}
+
+			if existingSGIndex == -1 {
+				existingSGIndex = slices.IndexFunc(existingSGs.SecurityGroups, func(existingSG *instance.SecurityGroup) bool {
+					return existingSG.Name == s.SecurityGroupName(sg.Name) && existingSG.Zone == zone
+				})
+			}
 
 			inboundDefaultPolicy, err := defaultPolicy(sg.Inbound)
 			if err != nil {
@@ -210,6 +240,8 @@ func (s *Service) ensureSecurityGroups(ctx context.Context, securityGroups []v1b
 				}
 			}
 
+			s.setStatusSecurityGroupID(sg.Name, zone, instanceSG.ID)
+
 			// Check if rules match what is expected.
 			rules, err := s.ScalewayClient.Instance.ListSecurityGroupRules(&instance.ListSecurityGroupRulesRequest{
 				Zone:            instanceSG.Zone,
@@ -292,6 +324,54 @@ func (s *Service) ensureSecurityGroups(ctx context.Context, securityGroups []v1b
 	return nil
 }
 
+// useExistingSecurityGroups verifies that the existing security groups exist and
+// sets their IDs in the status.
+func (s *Service) useExistingSecurityGroups(ctx context.Context, sg v1beta1.SecurityGroup) error {
+	ids := make(map[string]string, len(sg.IDs))
+
+	for zone, id := range sg.IDs {
+		if _, err := s.ScalewayClient.Instance.GetSecurityGroup(&instance.GetSecurityGroupRequest{
+			Zone:            scw.Zone(zone),
+			SecurityGroupID: id,
+		}, scw.WithContext(ctx)); err != nil {
+			return fmt.Errorf("failed to get existing security group with ID %s: %w", id, err)
+		}
+
+		ids[zone] = id
+	}
+
+	status := s.NetworkStatus()
+	if status.SecurityGroups == nil {
+		status.SecurityGroups = make(map[string]v1beta1.SecurityGroupStatus)
+	}
+
+	status.SecurityGroups[sg.Name] = v1beta1.SecurityGroupStatus{
+		IDs:      ids,
+		Imported: true,
+	}
+
+	return nil
+}
+
+// setStatusSecurityGroupID sets the ID of the security group for the provided
+// zone in the status.
+func (s *Service) setStatusSecurityGroupID(name string, zone scw.Zone, id string) {
+	status := s.NetworkStatus()
+
+	if status.SecurityGroups == nil {
+		status.SecurityGroups = make(map[string]v1beta1.SecurityGroupStatus)
+	}
+
+	sgStatus := status.SecurityGroups[name]
+	if sgStatus.IDs == nil || sgStatus.Imported {
+		sgStatus.IDs = make(map[string]string)
+	}
+
+	sgStatus.IDs[zone.String()] = id
+	sgStatus.Imported = false
+	status.SecurityGroups[name] = sgStatus
+}
+
 func (s *Service) Reconcile(ctx context.Context) error {
 	var securityGroups []v1beta1.SecurityGroup
 	if s.ScalewayCluster.Spec.Network != nil {
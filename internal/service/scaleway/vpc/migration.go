@@ -0,0 +1,76 @@
+package vpc
+
+import (
+	"context"
+
+	infrastructurev1beta1 "github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// startMigration starts tracking the migration of the machines to the Private
+// Network when it is enabled on a cluster that already has machines.
+func (s *Service) startMigration(ctx context.Context) error {
+	if status := s.ScalewayCluster.Status.Network; status != nil &&
+		(status.PrivateNetworkID != nil || status.Migration != nil) {
+		return nil
+	}
+
+	machines, err := s.Machines(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(machines) > 0 {
+		s.NetworkStatus().Migration = &infrastructurev1beta1.PrivateNetworkMigrationStatus{}
+	}
+
+	return nil
+}
+
+// ReconcileMigration updates the progress of the migration of the machines to
+// the Private Network. The machines are attached to the Private Network by
+// the ScalewayMachine controller and the loadbalancer backend servers are
+// switched to private IPs by the loadbalancer service.
+func (s *Service) ReconcileMigration(ctx context.Context) error {
+	status := s.ScalewayCluster.Status.Network
+	if !s.HasPrivateNetwork() || status == nil || status.Migration == nil || status.Migration.Completed {
+		return nil
+	}
+
+	machines, err := s.Machines(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pendingMachines []string
+
+	for _, machine := range machines {
+		if !slices.ContainsFunc(machine.Status.Addresses, func(address v1beta1.MachineAddress) bool {
+			return address.Type == v1beta1.MachineInternalIP
+		}) {
+			pendingMachines = append(pendingMachines, machine.Name)
+		}
+	}
+
+	var pendingBackendServers []string
+
+	if lbStatus := s.ScalewayCluster.Status.LoadBalancer; lbStatus != nil {
+		for _, zoneStatus := range append([]infrastructurev1beta1.LoadBalancerZoneStatus{lbStatus.LoadBalancerZoneStatus}, lbStatus.ExtraZones...) {
+			for _, name := range zoneStatus.PendingBackendServers {
+				if !slices.Contains(pendingBackendServers, name) {
+					pendingBackendServers = append(pendingBackendServers, name)
+				}
+			}
+		}
+	}
+
+	slices.Sort(pendingMachines)
+	slices.Sort(pendingBackendServers)
+
+	status.Migration.PendingMachines = pendingMachines
+	status.Migration.PendingBackendServers = pendingBackendServers
+	status.Migration.Completed = len(pendingMachines) == 0 && len(pendingBackendServers) == 0
+
+	return nil
+}
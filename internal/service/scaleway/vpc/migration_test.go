@@ -0,0 +1,110 @@
+package vpc
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	crfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconcileMigration(t *testing.T) {
+	machine := func(name string, addresses ...clusterv1beta1.MachineAddress) *v1beta1.ScalewayMachine {
+		return &v1beta1.ScalewayMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{clusterv1beta1.ClusterNameLabel: "test"},
+			},
+			Status: v1beta1.ScalewayMachineStatus{Addresses: addresses},
+		}
+	}
+
+	public := clusterv1beta1.MachineAddress{Type: clusterv1beta1.MachineExternalIP, Address: "51.15.0.2"}
+	private := clusterv1beta1.MachineAddress{Type: clusterv1beta1.MachineInternalIP, Address: "172.16.0.2"}
+
+	tests := []struct {
+		name          string
+		machines      []*v1beta1.ScalewayMachine
+		lbPending     []string
+		wantMachines  []string
+		wantServers   []string
+		wantCompleted bool
+	}{
+		{
+			name:         "machines are not attached",
+			machines:     []*v1beta1.ScalewayMachine{machine("m-0", public, private), machine("m-1", public)},
+			wantMachines: []string{"m-1"},
+		},
+		{
+			name:        "backend servers are not switched",
+			machines:    []*v1beta1.ScalewayMachine{machine("m-0", public, private)},
+			lbPending:   []string{"m-0"},
+			wantServers: []string{"m-0"},
+		},
+		{
+			name:          "migration completed",
+			machines:      []*v1beta1.ScalewayMachine{machine("m-0", public, private)},
+			wantCompleted: true,
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := crfake.NewClientBuilder().WithScheme(scheme)
+			for _, m := range tt.machines {
+				builder = builder.WithObjects(m)
+			}
+
+			s := NewService(&scope.Cluster{
+				Client:  builder.Build(),
+				Cluster: &clusterv1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				ScalewayCluster: &v1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+					Spec: v1beta1.ScalewayClusterSpec{
+						Region:  "fr-par",
+						Network: &v1beta1.NetworkSpec{PrivateNetwork: &v1beta1.PrivateNetworkSpec{Enabled: true}},
+					},
+					Status: v1beta1.ScalewayClusterStatus{
+						Network: &v1beta1.NetworkStatus{
+							PrivateNetworkID: scw.StringPtr(knownID),
+							Migration:        &v1beta1.PrivateNetworkMigrationStatus{},
+						},
+						LoadBalancer: &v1beta1.LoadBalancerStatus{
+							LoadBalancerZoneStatus: v1beta1.LoadBalancerZoneStatus{PendingBackendServers: tt.lbPending},
+						},
+					},
+				},
+			})
+
+			if err := s.ReconcileMigration(context.Background()); err != nil {
+				t.Fatalf("ReconcileMigration() error = %v", err)
+			}
+
+			migration := s.ScalewayCluster.Status.Network.Migration
+
+			if !reflect.DeepEqual(migration.PendingMachines, tt.wantMachines) {
+				t.Errorf("pending machines = %v, want %v", migration.PendingMachines, tt.wantMachines)
+			}
+
+			if !reflect.DeepEqual(migration.PendingBackendServers, tt.wantServers) {
+				t.Errorf("pending backend servers = %v, want %v", migration.PendingBackendServers, tt.wantServers)
+			}
+
+			if migration.Completed != tt.wantCompleted {
+				t.Errorf("completed = %v, want %v", migration.Completed, tt.wantCompleted)
+			}
+		})
+	}
+}
@@ -20,10 +20,33 @@ func NewService(clusterScope *scope.Cluster) *Service {
 	return &Service{clusterScope}
 }
 
+// getPN returns the Private Network of the cluster. It is retrieved by its ID
+// if it is known in the status, otherwise it is searched by name. It returns
+// client.ErrNoItemFound if the Private Network does not exist.
+func (s *Service) getPN(ctx context.Context) (*vpc.PrivateNetwork, error) {
+	region := s.Region()
+
+	if s.ScalewayCluster.Status.Network != nil && s.ScalewayCluster.Status.Network.PrivateNetworkID != nil {
+		pn, err := s.ScalewayClient.VPC.GetPrivateNetwork(&vpc.GetPrivateNetworkRequest{
+			Region:           region,
+			PrivateNetworkID: *s.ScalewayCluster.Status.Network.PrivateNetworkID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return pn, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ScalewayClient.FindPrivateNetworkByName(ctx, region, s.Name())
+}
+
 func (s *Service) getOrCreatePN(ctx context.Context) (*vpc.PrivateNetwork, error) {
 	region := s.Region()
 
-	pn, err := s.ScalewayClient.FindPrivateNetworkByName(ctx, region, s.Name())
+	pn, err := s.getPN(ctx)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return nil, err
 	}
@@ -44,12 +67,26 @@ func (s *Service) getOrCreatePN(ctx context.Context) (*vpc.PrivateNetwork, error
 			Region:  region,
 			Name:    s.Name(),
 			Subnets: subnets,
+			Tags:    s.Tags(),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	// Private Networks created by previous versions of the provider have no
+	// tags, they are needed to restore the status of the cluster.
+	if tags, ok := client.MergeTags(pn.Tags, s.Tags()); ok {
+		pn, err = s.ScalewayClient.VPC.UpdatePrivateNetwork(&vpc.UpdatePrivateNetworkRequest{
+			Region:           pn.Region,
+			PrivateNetworkID: pn.ID,
+			Tags:             &tags,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to tag Private Network: %w", err)
+		}
+	}
+
 	if !pn.DHCPEnabled {
 		return nil, errors.New("DHCP is not enabled in the specified Private Network")
 	}
@@ -58,7 +95,28 @@ func (s *Service) getOrCreatePN(ctx context.Context) (*vpc.PrivateNetwork, error
 }
 
 func (s *Service) Reconcile(ctx context.Context) error {
+	if !s.HasPrivateNetwork() {
+		return nil
+	}
+
+	if err := s.startMigration(ctx); err != nil {
+		return err
+	}
+
+	// Existing Private Network provided by the user.
 	if !s.ShouldManagePrivateNetwork() {
+		s.SetStatusPrivateNetworkID(*s.ScalewayCluster.Spec.Network.PrivateNetwork.ID)
+
+		pn, err := s.ScalewayClient.VPC.GetPrivateNetwork(&vpc.GetPrivateNetworkRequest{
+			Region:           s.Region(),
+			PrivateNetworkID: *s.ScalewayCluster.Spec.Network.PrivateNetwork.ID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return fmt.Errorf("failed to get Private Network: %w", err)
+		}
+
+		s.setStatusSubnets(pn)
+
 		return nil
 	}
 
@@ -68,18 +126,56 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	}
 
 	s.SetStatusPrivateNetworkID(pn.ID)
+	s.setStatusSubnets(pn)
 
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context) error {
+// setStatusSubnets sets the IPv4 subnets of the Private Network in the status.
+func (s *Service) setStatusSubnets(pn *vpc.PrivateNetwork) {
+	subnets := make([]string, 0, len(pn.Subnets))
+
+	for _, subnet := range pn.Subnets {
+		if subnet.Subnet.IP.To4() != nil {
+			subnets = append(subnets, subnet.Subnet.String())
+		}
+	}
+
+	s.NetworkStatus().PrivateNetworkSubnets = subnets
+}
+
+// Restore restores the Private Network ID in the status from the tags of the
+// Private Network. This is needed when the status was lost, for example after
+// the ScalewayCluster was moved to another management cluster.
+func (s *Service) Restore(ctx context.Context) error {
 	if !s.ShouldManagePrivateNetwork() {
 		return nil
 	}
 
-	region := s.Region()
+	if s.ScalewayCluster.Status.Network != nil && s.ScalewayCluster.Status.Network.PrivateNetworkID != nil {
+		return nil
+	}
+
+	pn, err := s.ScalewayClient.FindPrivateNetworkByTags(ctx, s.Region(), s.Tags())
+	if err != nil {
+		if errors.Is(err, client.ErrNoItemFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	s.SetStatusPrivateNetworkID(pn.ID)
+
+	return nil
+}
 
-	pn, err := s.ScalewayClient.FindPrivateNetworkByName(ctx, region, s.Name())
+func (s *Service) Delete(ctx context.Context) error {
+	if !s.ShouldManagePrivateNetwork() {
+		return nil
+	}
+
+	pn, err := s.getPN(ctx)
 	if err != nil {
 		if errors.Is(err, client.ErrNoItemFound) {
 			return nil
@@ -88,8 +184,12 @@ func (s *Service) Delete(ctx context.Context) error {
 		return err
 	}
 
-	return s.ScalewayClient.VPC.DeletePrivateNetwork(&vpc.DeletePrivateNetworkRequest{
-		Region:           region,
+	if err := s.ScalewayClient.VPC.DeletePrivateNetwork(&vpc.DeletePrivateNetworkRequest{
+		Region:           s.Region(),
 		PrivateNetworkID: pn.ID,
-	}, scw.WithContext(ctx))
+	}, scw.WithContext(ctx)); err != nil && !client.IsNotFoundError(err) {
+		return err
+	}
+
+	return nil
 }
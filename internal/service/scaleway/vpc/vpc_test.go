@@ -0,0 +1,131 @@
+package vpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	"github.com/scaleway/scaleway-sdk-go/api/vpc/v2"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	pnPath  = "/vpc/v2/regions/fr-par/private-networks"
+	knownID = "22222222-2222-2222-2222-222222222222"
+)
+
+func TestGetPN(t *testing.T) {
+	// A Private Network with the name of the cluster that was not created by
+	// the provider.
+	lookalike := &vpc.PrivateNetwork{ID: "33333333-3333-3333-3333-333333333333", Name: "caps-test", Region: scw.RegionFrPar}
+
+	tests := []struct {
+		name       string
+		statusID   *string
+		known      bool
+		missing    bool
+		wantID     string
+		wantErr    error
+		wantListed bool
+	}{
+		{
+			name:     "known ID",
+			statusID: scw.StringPtr(knownID),
+			known:    true,
+			wantID:   knownID,
+		},
+		{
+			name:       "known ID of a deleted Private Network",
+			statusID:   scw.StringPtr(knownID),
+			wantID:     lookalike.ID,
+			wantListed: true,
+		},
+		{
+			name:       "unknown ID",
+			wantID:     lookalike.ID,
+			wantListed: true,
+		},
+		{
+			name:       "no Private Network",
+			statusID:   scw.StringPtr(knownID),
+			missing:    true,
+			wantErr:    client.ErrNoItemFound,
+			wantListed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &vpc.ListPrivateNetworksResponse{
+				PrivateNetworks: []*vpc.PrivateNetwork{lookalike},
+				TotalCount:      1,
+			}
+			if tt.missing {
+				list = &vpc.ListPrivateNetworksResponse{}
+			}
+
+			api := fake.NewAPI()
+			api.JSON("GET "+pnPath, list)
+
+			if tt.known {
+				api.JSON("GET "+pnPath+"/"+knownID, &vpc.PrivateNetwork{ID: knownID, Name: "caps-test", Region: scw.RegionFrPar})
+			}
+
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, api),
+				ScalewayCluster: &v1beta1.ScalewayCluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec:       v1beta1.ScalewayClusterSpec{Region: "fr-par"},
+					Status: v1beta1.ScalewayClusterStatus{
+						Network: &v1beta1.NetworkStatus{PrivateNetworkID: tt.statusID},
+					},
+				},
+			})
+
+			pn, err := s.getPN(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("getPN() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if pn != nil && pn.ID != tt.wantID {
+				t.Errorf("getPN() = %s, want %s", pn.ID, tt.wantID)
+			}
+
+			if listed := api.Called("GET " + pnPath); listed != tt.wantListed {
+				t.Errorf("Private Networks listed = %v, want %v", listed, tt.wantListed)
+			}
+		})
+	}
+}
+
+func TestDeleteUnknownPN(t *testing.T) {
+	api := fake.NewAPI()
+	api.JSON("GET "+pnPath, &vpc.ListPrivateNetworksResponse{})
+
+	s := NewService(&scope.Cluster{
+		ScalewayClient: fake.NewClient(t, api),
+		ScalewayCluster: &v1beta1.ScalewayCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "test"},
+			Spec: v1beta1.ScalewayClusterSpec{
+				Region:  "fr-par",
+				Network: &v1beta1.NetworkSpec{PrivateNetwork: &v1beta1.PrivateNetworkSpec{Enabled: true}},
+			},
+			Status: v1beta1.ScalewayClusterStatus{
+				Network: &v1beta1.NetworkStatus{PrivateNetworkID: scw.StringPtr(knownID)},
+			},
+		},
+	})
+
+	if err := s.Delete(context.Background()); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	if api.Called("DELETE " + pnPath + "/" + knownID) {
+		t.Errorf("Delete() deleted a Private Network that does not exist")
+	}
+}
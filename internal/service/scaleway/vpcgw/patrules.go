@@ -0,0 +1,150 @@
+package vpcgw
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	clusterv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// patRules returns the PAT rules of the spec, sorted by public port. The rules
+// that target a ScalewayMachine that has no private IP yet are skipped: they
+// are added once the ScalewayMachine is updated.
+func (s *Service) patRules(ctx context.Context) ([]*vpcgw.SetPATRulesRequestRule, error) {
+	specRules := s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.PATRules
+	if len(specRules) == 0 {
+		return nil, nil
+	}
+
+	machines, err := s.ClusterScope.Machines(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*vpcgw.SetPATRulesRequestRule, 0, len(specRules))
+
+	for _, rule := range specRules {
+		var privateIP string
+
+		switch {
+		case rule.Target.PrivateIP != nil:
+			privateIP = *rule.Target.PrivateIP
+		case rule.Target.ScalewayMachine != nil:
+			privateIP = machinePrivateIP(machines, *rule.Target.ScalewayMachine)
+			if privateIP == "" {
+				log.FromContext(ctx).Info("skipping PAT rule: ScalewayMachine has no private IP yet",
+					"publicPort", rule.PublicPort, "scalewayMachine", *rule.Target.ScalewayMachine)
+				continue
+			}
+		}
+
+		ip := net.ParseIP(privateIP)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid private IP %q in PAT rule for public port %d", privateIP, rule.PublicPort)
+		}
+
+		privatePort := rule.PublicPort
+		if rule.PrivatePort != nil {
+			privatePort = *rule.PrivatePort
+		}
+
+		protocol := v1beta1.PATRuleProtocolTCP
+		if rule.Protocol != nil {
+			protocol = *rule.Protocol
+		}
+
+		rules = append(rules, &vpcgw.SetPATRulesRequestRule{
+			PublicPort:  rule.PublicPort,
+			PrivateIP:   ip,
+			PrivatePort: privatePort,
+			Protocol:    vpcgw.PATRuleProtocol(protocol),
+		})
+	}
+
+	slices.SortFunc(rules, func(a, b *vpcgw.SetPATRulesRequestRule) int {
+		return int(a.PublicPort) - int(b.PublicPort)
+	})
+
+	return rules, nil
+}
+
+// machinePrivateIP returns the private IP of the ScalewayMachine with the
+// provided name, or an empty string if it is not known.
+func machinePrivateIP(machines []v1beta1.ScalewayMachine, name string) string {
+	for _, m := range machines {
+		if m.Name != name {
+			continue
+		}
+
+		for _, address := range m.Status.Addresses {
+			if address.Type == clusterv1beta1.MachineInternalIP {
+				return address.Address
+			}
+		}
+	}
+
+	return ""
+}
+
+// comparePATRules compares the expected PAT rules with the PAT rules of the
+// Public Gateway. It returns true if both lists are equal.
+func comparePATRules(expected []*vpcgw.SetPATRulesRequestRule, current []*vpcgw.PATRule) bool {
+	if len(expected) != len(current) {
+		return false
+	}
+
+	current = slices.Clone(current)
+	slices.SortFunc(current, func(a, b *vpcgw.PATRule) int {
+		return int(a.PublicPort) - int(b.PublicPort)
+	})
+
+	for i := range expected {
+		if expected[i].PublicPort != current[i].PublicPort ||
+			!expected[i].PrivateIP.Equal(current[i].PrivateIP) ||
+			expected[i].PrivatePort != current[i].PrivatePort ||
+			expected[i].Protocol != current[i].Protocol {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ensurePATRules ensures the PAT rules of the Public Gateway match the expected
+// rules. The PAT rules of an existing Public Gateway are only managed when PAT
+// rules are set in the spec.
+func (s *Service) ensurePATRules(ctx context.Context, gatewayID string, rules []*vpcgw.SetPATRulesRequestRule) error {
+	if s.existingGatewayID() != nil && len(s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.PATRules) == 0 {
+		return nil
+	}
+
+	current, err := s.ClusterScope.ScalewayClient.VPCGW.ListPATRules(&vpcgw.ListPATRulesRequest{
+		Zone:      s.zone,
+		GatewayID: &gatewayID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return fmt.Errorf("failed to list PAT rules: %w", err)
+	}
+
+	if comparePATRules(rules, current.PatRules) {
+		return nil
+	}
+
+	if _, err := s.ClusterScope.ScalewayClient.VPCGW.SetPATRules(&vpcgw.SetPATRulesRequest{
+		Zone:      s.zone,
+		GatewayID: gatewayID,
+		PatRules:  rules,
+	}, scw.WithContext(ctx)); err != nil {
+		return fmt.Errorf("failed to set PAT rules: %w", err)
+	}
+
+	log.FromContext(ctx).Info("PAT rules were updated", "zone", s.zone)
+
+	return nil
+}
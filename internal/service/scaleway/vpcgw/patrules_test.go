@@ -0,0 +1,76 @@
+package vpcgw
+
+import (
+	"net"
+	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+)
+
+func TestComparePATRules(t *testing.T) {
+	expected := []*vpcgw.SetPATRulesRequestRule{
+		{PublicPort: 2222, PrivateIP: net.ParseIP("172.16.0.2").To4(), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolTCP},
+		{PublicPort: 3333, PrivateIP: net.ParseIP("172.16.0.3").To4(), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolBoth},
+	}
+
+	tests := []struct {
+		name    string
+		current []*vpcgw.PATRule
+		want    bool
+	}{
+		{
+			name: "same rules in another order",
+			current: []*vpcgw.PATRule{
+				{PublicPort: 3333, PrivateIP: net.ParseIP("172.16.0.3"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolBoth},
+				{PublicPort: 2222, PrivateIP: net.ParseIP("172.16.0.2"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolTCP},
+			},
+			want: true,
+		},
+		{
+			name: "missing rule",
+			current: []*vpcgw.PATRule{
+				{PublicPort: 2222, PrivateIP: net.ParseIP("172.16.0.2"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolTCP},
+			},
+		},
+		{
+			name: "different private IP",
+			current: []*vpcgw.PATRule{
+				{PublicPort: 2222, PrivateIP: net.ParseIP("172.16.0.4"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolTCP},
+				{PublicPort: 3333, PrivateIP: net.ParseIP("172.16.0.3"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolBoth},
+			},
+		},
+		{
+			name: "different private port",
+			current: []*vpcgw.PATRule{
+				{PublicPort: 2222, PrivateIP: net.ParseIP("172.16.0.2"), PrivatePort: 2222, Protocol: vpcgw.PATRuleProtocolTCP},
+				{PublicPort: 3333, PrivateIP: net.ParseIP("172.16.0.3"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolBoth},
+			},
+		},
+		{
+			name: "different protocol",
+			current: []*vpcgw.PATRule{
+				{PublicPort: 2222, PrivateIP: net.ParseIP("172.16.0.2"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolUDP},
+				{PublicPort: 3333, PrivateIP: net.ParseIP("172.16.0.3"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolBoth},
+			},
+		},
+		{
+			name: "different public port",
+			current: []*vpcgw.PATRule{
+				{PublicPort: 2223, PrivateIP: net.ParseIP("172.16.0.2"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolTCP},
+				{PublicPort: 3333, PrivateIP: net.ParseIP("172.16.0.3"), PrivatePort: 22, Protocol: vpcgw.PATRuleProtocolBoth},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := comparePATRules(expected, tt.current); got != tt.want {
+				t.Errorf("comparePATRules() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if !comparePATRules(nil, nil) {
+		t.Errorf("comparePATRules() = false for empty rules, want true")
+	}
+}
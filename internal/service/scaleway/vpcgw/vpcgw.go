@@ -5,40 +5,150 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
 	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client"
 	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"golang.org/x/exp/slices"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
-const defaultVPCGWType = "VPC-GW-S"
+const (
+	defaultVPCGWType   = "VPC-GW-S"
+	defaultBastionPort = 61000
+)
 
 type Service struct {
 	ClusterScope *scope.Cluster
+	zone         scw.Zone
 }
 
 func NewService(clusterScope *scope.Cluster) *Service {
-	return &Service{clusterScope}
+	return &Service{
+		ClusterScope: clusterScope,
+		zone:         clusterScope.PublicGatewayZone(),
+	}
+}
+
+// forZone returns a service that manages the Public Gateway of the provided
+// zone.
+func (s *Service) forZone(zone scw.Zone) *Service {
+	return &Service{
+		ClusterScope: s.ClusterScope,
+		zone:         zone,
+	}
+}
+
+// isMainZone returns true if the service manages the main Public Gateway.
+func (s *Service) isMainZone() bool {
+	return s.zone == s.ClusterScope.PublicGatewayZone()
+}
+
+// status returns the status of the Public Gateway of the service zone. The
+// status is initialized if it is not set yet.
+func (s *Service) status() *v1beta1.PublicGatewayStatus {
+	status := s.ClusterScope.NetworkStatus()
+
+	if s.isMainZone() {
+		status.PublicGatewayZone = scw.StringPtr(s.zone.String())
+		return &status.PublicGatewayStatus
+	}
+
+	for i := range status.ExtraPublicGateways {
+		if status.ExtraPublicGateways[i].PublicGatewayZone != nil &&
+			*status.ExtraPublicGateways[i].PublicGatewayZone == s.zone.String() {
+			return &status.ExtraPublicGateways[i]
+		}
+	}
+
+	status.ExtraPublicGateways = append(status.ExtraPublicGateways, v1beta1.PublicGatewayStatus{
+		PublicGatewayZone: scw.StringPtr(s.zone.String()),
+	})
+
+	return &status.ExtraPublicGateways[len(status.ExtraPublicGateways)-1]
+}
+
+// existingGatewayID returns the ID of the existing Public Gateway that is
+// provided in the spec. It is only used for the main zone.
+func (s *Service) existingGatewayID() *string {
+	if !s.isMainZone() {
+		return nil
+	}
+
+	return s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.ID
 }
 
-func (s *Service) getOrCreateIP(ctx context.Context, zone scw.Zone, existingIP *string) (*vpcgw.IP, error) {
-	if existingIP != nil {
-		ip, err := s.ClusterScope.ScalewayClient.FindGatewayIP(ctx, zone, *s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.IP)
+// existingIP returns the existing IP that is provided in the spec. It is only
+// used for the main zone.
+func (s *Service) existingIP() *string {
+	if !s.isMainZone() {
+		return nil
+	}
+
+	return s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.IP
+}
+
+// getIP returns the Public Gateway IP that was created for the cluster. It is
+// retrieved by its ID if it is known in the status, otherwise it is searched
+// by tags. It returns client.ErrNoItemFound if the IP does not exist.
+func (s *Service) getIP(ctx context.Context) (*vpcgw.IP, error) {
+	if status := s.status(); status.PublicGatewayIPID != nil {
+		ip, err := s.ClusterScope.ScalewayClient.VPCGW.GetIP(&vpcgw.GetIPRequest{
+			Zone: s.zone,
+			IPID: *status.PublicGatewayIPID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return ip, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ClusterScope.ScalewayClient.FindGatewayIPByTags(ctx, s.zone, s.ClusterScope.Tags())
+}
+
+// getGateway returns the Public Gateway that was created for the cluster. It is
+// retrieved by its ID if it is known in the status, otherwise it is searched
+// by name. It returns client.ErrNoItemFound if the Public Gateway does not exist.
+func (s *Service) getGateway(ctx context.Context) (*vpcgw.Gateway, error) {
+	if status := s.status(); status.PublicGatewayID != nil {
+		gw, err := s.ClusterScope.ScalewayClient.VPCGW.GetGateway(&vpcgw.GetGatewayRequest{
+			Zone:      s.zone,
+			GatewayID: *status.PublicGatewayID,
+		}, scw.WithContext(ctx))
+		if err == nil {
+			return gw, nil
+		}
+
+		if !client.IsNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	return s.ClusterScope.ScalewayClient.FindGatewayByName(ctx, s.zone, s.ClusterScope.Name())
+}
+
+func (s *Service) getOrCreateIP(ctx context.Context) (*vpcgw.IP, error) {
+	if existingIP := s.existingIP(); existingIP != nil {
+		ip, err := s.ClusterScope.ScalewayClient.FindGatewayIP(ctx, s.zone, *existingIP)
 		if err != nil {
-			return nil, fmt.Errorf("failed to find IP %q: %w", *s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.IP, err)
+			return nil, fmt.Errorf("failed to find IP %q: %w", *existingIP, err)
 		}
 
 		return ip, nil
 	}
 
-	ip, err := s.ClusterScope.ScalewayClient.FindGatewayIPByTags(ctx, zone, s.ClusterScope.Tags())
+	ip, err := s.getIP(ctx)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return nil, err
 	}
 
 	if ip == nil {
 		ip, err = s.ClusterScope.ScalewayClient.VPCGW.CreateIP(&vpcgw.CreateIPRequest{
-			Zone: zone,
+			Zone: s.zone,
 			Tags: s.ClusterScope.Tags(),
 		})
 		if err != nil {
@@ -49,14 +159,14 @@ func (s *Service) getOrCreateIP(ctx context.Context, zone scw.Zone, existingIP *
 	return ip, nil
 }
 
-func (s *Service) getOrCreateGateway(ctx context.Context, zone scw.Zone) (*vpcgw.Gateway, error) {
-	gw, err := s.ClusterScope.ScalewayClient.FindGatewayByName(ctx, zone, s.ClusterScope.Name())
+func (s *Service) getOrCreateGateway(ctx context.Context) (*vpcgw.Gateway, error) {
+	gw, err := s.getGateway(ctx)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
-		return nil, fmt.Errorf("failed to find Public Gateway by name: %w", err)
+		return nil, fmt.Errorf("failed to find Public Gateway: %w", err)
 	}
 
 	if gw == nil {
-		ip, err := s.getOrCreateIP(ctx, zone, s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.IP)
+		ip, err := s.getOrCreateIP(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -67,34 +177,182 @@ func (s *Service) getOrCreateGateway(ctx context.Context, zone scw.Zone) (*vpcgw
 		}
 
 		gw, err = s.ClusterScope.ScalewayClient.VPCGW.CreateGateway(&vpcgw.CreateGatewayRequest{
-			Zone: zone,
+			Zone: s.zone,
 			Name: s.ClusterScope.Name(),
 			IPID: &ip.ID,
 			Type: *vpcgwType,
+			Tags: s.ClusterScope.Tags(),
 		}, scw.WithContext(ctx))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create Public Gateway: %w", err)
 		}
 	}
 
+	// Public Gateways created by previous versions of the provider have no
+	// tags, they are needed to restore the status of the cluster.
+	if tags, ok := client.MergeTags(gw.Tags, s.ClusterScope.Tags()); ok {
+		gw, err = s.ClusterScope.ScalewayClient.VPCGW.UpdateGateway(&vpcgw.UpdateGatewayRequest{
+			Zone:      gw.Zone,
+			GatewayID: gw.ID,
+			Tags:      &tags,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to tag Public Gateway: %w", err)
+		}
+	}
+
+	if gw.IP != nil {
+		s.status().PublicGatewayIPID = &gw.IP.ID
+	}
+
 	return gw, nil
 }
 
-func (s *Service) Reconcile(ctx context.Context) error {
-	if !s.ClusterScope.HasPrivateNetwork() || !s.ClusterScope.HasPublicGateway() {
-		return nil
+// getOrCreateGatewayNetwork ensures the Public Gateway is attached to the
+// Private Network. Only the main Public Gateway advertises the default route
+// when the attachment is created.
+func (s *Service) getOrCreateGatewayNetwork(ctx context.Context, gatewayID, pnID string) (*vpcgw.GatewayNetwork, error) {
+	if status := s.status(); status.GatewayNetworkID != nil {
+		gwNetwork, err := s.ClusterScope.ScalewayClient.VPCGW.GetGatewayNetwork(&vpcgw.GetGatewayNetworkRequest{
+			Zone:             s.zone,
+			GatewayNetworkID: *status.GatewayNetworkID,
+		}, scw.WithContext(ctx))
+		if err != nil && !client.IsNotFoundError(err) {
+			return nil, err
+		}
+
+		if err == nil && gwNetwork.GatewayID == gatewayID && gwNetwork.PrivateNetworkID == pnID {
+			return gwNetwork, nil
+		}
+	}
+
+	// Check if gateway is already attached to the PN.
+	gwNeworks, err := s.ClusterScope.ScalewayClient.VPCGW.ListGatewayNetworks(&vpcgw.ListGatewayNetworksRequest{
+		Zone:             s.zone,
+		GatewayID:        &gatewayID,
+		PrivateNetworkID: &pnID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(gwNeworks.GatewayNetworks) > 0 {
+		return gwNeworks.GatewayNetworks[0], nil
+	}
+
+	return s.ClusterScope.ScalewayClient.VPCGW.CreateGatewayNetwork(&vpcgw.CreateGatewayNetworkRequest{
+		Zone:             s.zone,
+		GatewayID:        gatewayID,
+		PrivateNetworkID: pnID,
+		EnableDHCP:       scw.BoolPtr(true),
+		EnableMasquerade: true,
+		IpamConfig: &vpcgw.CreateGatewayNetworkRequestIpamConfig{
+			PushDefaultRoute: s.isMainZone(),
+		},
+	}, scw.WithContext(ctx))
+}
+
+// ensureBastion ensures the SSH bastion of the Public Gateway matches the spec.
+// The bastion is left untouched if it is not configured in the spec.
+func (s *Service) ensureBastion(ctx context.Context, gw *vpcgw.Gateway) (*vpcgw.Gateway, error) {
+	bastion := s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.Bastion
+	if bastion == nil {
+		return gw, nil
 	}
 
-	zone := s.ClusterScope.PublicGatewayZone()
-	gatewayID := s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.ID
+	port := uint32(defaultBastionPort)
+	if bastion.Port != nil {
+		port = *bastion.Port
+	}
+
+	if gw.BastionEnabled == bastion.Enabled && (!bastion.Enabled || gw.BastionPort == port) {
+		return gw, nil
+	}
 
-	if gatewayID == nil {
-		gw, err := s.getOrCreateGateway(ctx, zone)
+	req := &vpcgw.UpdateGatewayRequest{
+		Zone:          s.zone,
+		GatewayID:     gw.ID,
+		EnableBastion: scw.BoolPtr(bastion.Enabled),
+	}
+
+	if bastion.Enabled {
+		req.BastionPort = scw.Uint32Ptr(port)
+	}
+
+	gw, err := s.ClusterScope.ScalewayClient.VPCGW.UpdateGateway(req, scw.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update Public Gateway bastion: %w", err)
+	}
+
+	return gw, nil
+}
+
+// reconcileZone reconciles the Public Gateway of the service zone and returns
+// its attachment to the Private Network. The PAT rules are set on every Public
+// Gateway.
+func (s *Service) reconcileZone(ctx context.Context, pnID string, patRules []*vpcgw.SetPATRulesRequestRule) (*vpcgw.GatewayNetwork, error) {
+	var (
+		gw  *vpcgw.Gateway
+		err error
+	)
+
+	if gatewayID := s.existingGatewayID(); gatewayID != nil {
+		gw, err = s.ClusterScope.ScalewayClient.VPCGW.GetGateway(&vpcgw.GetGatewayRequest{
+			Zone:      s.zone,
+			GatewayID: *gatewayID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Public Gateway %q: %w", *gatewayID, err)
+		}
+	} else {
+		gw, err = s.getOrCreateGateway(ctx)
 		if err != nil {
-			return err
+			return nil, err
 		}
+	}
+
+	s.status().PublicGatewayID = &gw.ID
 
-		gatewayID = &gw.ID
+	gw, err = s.ensureBastion(ctx, gw)
+	if err != nil {
+		return nil, err
+	}
+
+	s.status().PublicGatewayIP = nil
+	if gw.IP != nil {
+		s.status().PublicGatewayIP = scw.StringPtr(gw.IP.Address.String())
+	}
+
+	s.status().BastionPort = nil
+	if gw.BastionEnabled {
+		s.status().BastionPort = scw.Uint32Ptr(gw.BastionPort)
+	}
+
+	gwNetwork, err := s.getOrCreateGatewayNetwork(ctx, gw.ID, pnID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach Public Gateway to Private Network: %w", err)
+	}
+
+	s.status().GatewayNetworkID = &gwNetwork.ID
+
+	if err := s.ensurePATRules(ctx, gw.ID, patRules); err != nil {
+		return nil, err
+	}
+
+	return gwNetwork, nil
+}
+
+// ErrZoneNotReady is returned when the Public Gateway of at least one zone
+// cannot be reconciled while the default route is advertised by a healthy
+// Public Gateway.
+var ErrZoneNotReady = errors.New("public gateway of a zone is not ready")
+
+// Reconcile reconciles the Public Gateways of all the zones. The zones are
+// reconciled independently so that the default route can be moved away from
+// a zone whose Public Gateway is unavailable.
+func (s *Service) Reconcile(ctx context.Context) error {
+	if !s.ClusterScope.HasPrivateNetwork() || !s.ClusterScope.HasPublicGateway() {
+		return nil
 	}
 
 	pnID, err := s.ClusterScope.PrivateNetworkID()
@@ -102,73 +360,299 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		return err
 	}
 
-	// Check if gateway is already attached to the PN.
-	gwNeworks, err := s.ClusterScope.ScalewayClient.VPCGW.ListGatewayNetworks(&vpcgw.ListGatewayNetworksRequest{
-		Zone:             zone,
-		GatewayID:        gatewayID,
-		PrivateNetworkID: &pnID,
-	}, scw.WithContext(ctx), scw.WithAllPages())
+	patRules, err := s.patRules(ctx)
 	if err != nil {
 		return err
 	}
 
-	if gwNeworks.TotalCount == 0 {
-		if _, err := s.ClusterScope.ScalewayClient.VPCGW.CreateGatewayNetwork(&vpcgw.CreateGatewayNetworkRequest{
-			Zone:             zone,
-			GatewayID:        *gatewayID,
-			PrivateNetworkID: pnID,
-			EnableDHCP:       scw.BoolPtr(true),
-			EnableMasquerade: true,
-			IpamConfig: &vpcgw.CreateGatewayNetworkRequestIpamConfig{
-				PushDefaultRoute: true,
+	zones := s.ClusterScope.PublicGatewayZones()
+	gwNetworks := make([]*vpcgw.GatewayNetwork, 0, len(zones))
+
+	var errs []error
+
+	for _, zone := range zones {
+		gwNetwork, err := s.forZone(zone).reconcileZone(ctx, pnID, patRules)
+		if err != nil {
+			log.FromContext(ctx).Info("failed to reconcile Public Gateway", "zone", zone, "err", err)
+			errs = append(errs, fmt.Errorf("failed to reconcile Public Gateway in zone %s: %w", zone, err))
+
+			// The attachment is still needed to stop advertising the
+			// default route from this zone.
+			if gwNetwork, err = s.forZone(zone).getStatusGatewayNetwork(ctx); err != nil {
+				continue
+			}
+		}
+
+		gwNetworks = append(gwNetworks, gwNetwork)
+	}
+
+	if err := s.pruneExtraZones(ctx); err != nil {
+		errs = append(errs, err)
+	}
+
+	// With a single Public Gateway, the default route is never moved.
+	if len(zones) == 1 {
+		if len(gwNetworks) == 1 {
+			s.status().DefaultRoute = pushesDefaultRoute(gwNetworks[0])
+		}
+
+		return errors.Join(errs...)
+	}
+
+	if err := s.ensureDefaultRoute(ctx, gwNetworks); err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrZoneNotReady, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// getStatusGatewayNetwork returns the attachment of the Public Gateway to the
+// Private Network that is known in the status.
+func (s *Service) getStatusGatewayNetwork(ctx context.Context) (*vpcgw.GatewayNetwork, error) {
+	status := s.status()
+	if status.GatewayNetworkID == nil {
+		return nil, client.ErrNoItemFound
+	}
+
+	return s.ClusterScope.ScalewayClient.VPCGW.GetGatewayNetwork(&vpcgw.GetGatewayNetworkRequest{
+		Zone:             s.zone,
+		GatewayNetworkID: *status.GatewayNetworkID,
+	}, scw.WithContext(ctx))
+}
+
+// ensureDefaultRoute ensures that a single healthy Public Gateway advertises the
+// default route of the Private Network. The main Public Gateway is preferred,
+// the default route is only moved to another zone when it is not healthy.
+func (s *Service) ensureDefaultRoute(ctx context.Context, gwNetworks []*vpcgw.GatewayNetwork) error {
+	var preferred *vpcgw.GatewayNetwork
+
+	for _, gwNetwork := range gwNetworks {
+		healthy, err := s.isHealthy(ctx, gwNetwork)
+		if err != nil {
+			log.FromContext(ctx).Info("failed to check health of Public Gateway", "zone", gwNetwork.Zone, "err", err)
+			continue
+		}
+
+		if healthy {
+			preferred = gwNetwork
+			break
+		}
+	}
+
+	// Keep the current configuration if no Public Gateway is healthy.
+	if preferred == nil {
+		for _, gwNetwork := range gwNetworks {
+			s.forZone(gwNetwork.Zone).status().DefaultRoute = pushesDefaultRoute(gwNetwork)
+		}
+
+		return nil
+	}
+
+	// Stop advertising the default route from the other Public Gateways
+	// before advertising it from the preferred one. An unavailable Public
+	// Gateway must not prevent the default route from being moved.
+	var errs []error
+
+	for _, gwNetwork := range gwNetworks {
+		if gwNetwork != preferred {
+			if err := s.setDefaultRoute(ctx, gwNetwork, false); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if err := s.setDefaultRoute(ctx, preferred, true); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// isHealthy returns true if the Public Gateway is running and attached to the
+// Private Network.
+func (s *Service) isHealthy(ctx context.Context, gwNetwork *vpcgw.GatewayNetwork) (bool, error) {
+	if gwNetwork.Status != vpcgw.GatewayNetworkStatusReady {
+		return false, nil
+	}
+
+	gw, err := s.ClusterScope.ScalewayClient.VPCGW.GetGateway(&vpcgw.GetGatewayRequest{
+		Zone:      gwNetwork.Zone,
+		GatewayID: gwNetwork.GatewayID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return false, fmt.Errorf("failed to get Public Gateway in zone %s: %w", gwNetwork.Zone, err)
+	}
+
+	return gw.Status == vpcgw.GatewayStatusRunning, nil
+}
+
+// setDefaultRoute configures whether the Public Gateway advertises the default
+// route of the Private Network.
+func (s *Service) setDefaultRoute(ctx context.Context, gwNetwork *vpcgw.GatewayNetwork, enabled bool) error {
+	if pushesDefaultRoute(gwNetwork) != enabled {
+		if _, err := s.ClusterScope.ScalewayClient.VPCGW.UpdateGatewayNetwork(&vpcgw.UpdateGatewayNetworkRequest{
+			Zone:             gwNetwork.Zone,
+			GatewayNetworkID: gwNetwork.ID,
+			IpamConfig: &vpcgw.UpdateGatewayNetworkRequestIpamConfig{
+				PushDefaultRoute: &enabled,
 			},
 		}, scw.WithContext(ctx)); err != nil {
-			return err
+			return fmt.Errorf("failed to update default route of Public Gateway in zone %s: %w", gwNetwork.Zone, err)
 		}
 	}
 
-	// TODO: set public gateway ID in status.
+	s.forZone(gwNetwork.Zone).status().DefaultRoute = enabled
 
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context) error {
+func pushesDefaultRoute(gwNetwork *vpcgw.GatewayNetwork) bool {
+	return gwNetwork.IpamConfig != nil && gwNetwork.IpamConfig.PushDefaultRoute
+}
+
+// pruneExtraZones deletes the Public Gateways of the zones that are no longer
+// failure domains of the cluster.
+func (s *Service) pruneExtraZones(ctx context.Context) error {
+	zones := s.ClusterScope.PublicGatewayZones()
+
+	for _, zone := range s.statusExtraZones() {
+		if slices.Contains(zones, zone) {
+			continue
+		}
+
+		if err := s.forZone(zone).deleteZone(ctx); err != nil {
+			return fmt.Errorf("failed to delete Public Gateway in zone %s: %w", zone, err)
+		}
+
+		status := s.ClusterScope.NetworkStatus()
+		status.ExtraPublicGateways = slices.DeleteFunc(status.ExtraPublicGateways, func(gw v1beta1.PublicGatewayStatus) bool {
+			return gw.PublicGatewayZone != nil && *gw.PublicGatewayZone == zone.String()
+		})
+	}
+
+	return nil
+}
+
+// statusExtraZones returns the zones of the extra Public Gateways that are
+// known in the status.
+func (s *Service) statusExtraZones() []scw.Zone {
+	status := s.ClusterScope.ScalewayCluster.Status.Network
+	if status == nil {
+		return nil
+	}
+
+	zones := make([]scw.Zone, 0, len(status.ExtraPublicGateways))
+	for _, gw := range status.ExtraPublicGateways {
+		if gw.PublicGatewayZone != nil {
+			zones = append(zones, scw.Zone(*gw.PublicGatewayZone))
+		}
+	}
+
+	return zones
+}
+
+// Restore restores the Public Gateway IDs in the status from the tags of the
+// Public Gateways. This is needed when the status was lost, for example after
+// the ScalewayCluster was moved to another management cluster.
+func (s *Service) Restore(ctx context.Context) error {
 	if !s.ClusterScope.HasPrivateNetwork() || !s.ClusterScope.HasPublicGateway() {
 		return nil
 	}
 
-	if s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.ID != nil {
+	for _, zone := range s.ClusterScope.PublicGatewayZones() {
+		if err := s.forZone(zone).restoreZone(ctx); err != nil {
+			return fmt.Errorf("failed to restore Public Gateway in zone %s: %w", zone, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) restoreZone(ctx context.Context) error {
+	if s.existingGatewayID() != nil {
 		return nil
 	}
 
-	zone := s.ClusterScope.PublicGatewayZone()
+	if s.status().PublicGatewayID != nil {
+		return nil
+	}
+
+	gw, err := s.ClusterScope.ScalewayClient.FindGatewayByTags(ctx, s.zone, s.ClusterScope.Tags())
+	if err != nil {
+		if errors.Is(err, client.ErrNoItemFound) {
+			return nil
+		}
+
+		return err
+	}
+
+	s.status().PublicGatewayID = &gw.ID
+
+	if gw.IP != nil {
+		s.status().PublicGatewayIPID = &gw.IP.ID
+	}
+
+	return nil
+}
+
+// Delete deletes the Public Gateways of all the zones, including the zones
+// that are no longer failure domains but are still known in the status.
+func (s *Service) Delete(ctx context.Context) error {
+	if !s.ClusterScope.HasPrivateNetwork() || !s.ClusterScope.HasPublicGateway() {
+		return nil
+	}
+
+	zones := s.ClusterScope.PublicGatewayZones()
+	for _, zone := range s.statusExtraZones() {
+		if !slices.Contains(zones, zone) {
+			zones = append(zones, zone)
+		}
+	}
+
+	for _, zone := range zones {
+		if err := s.forZone(zone).deleteZone(ctx); err != nil {
+			return fmt.Errorf("failed to delete Public Gateway in zone %s: %w", zone, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) deleteZone(ctx context.Context) error {
+	if s.existingGatewayID() != nil {
+		return nil
+	}
 
-	gw, err := s.ClusterScope.ScalewayClient.FindGatewayByName(ctx, zone, s.ClusterScope.Name())
+	gw, err := s.getGateway(ctx)
 	if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 		return fmt.Errorf("failed to find PublicGateway: %w", err)
 	}
 
 	if err == nil {
 		if err := s.ClusterScope.ScalewayClient.PublicGateway.DeleteGateway(&vpcgw.DeleteGatewayRequest{
-			Zone:      zone,
+			Zone:      s.zone,
 			GatewayID: gw.ID,
-		}); err != nil {
+		}); err != nil && !client.IsNotFoundError(err) {
 			return fmt.Errorf("failed to delete PublicGateway: %w", err)
 		}
 	}
 
 	// Release IP if an IP was automatically created.
-	if s.ClusterScope.ScalewayCluster.Spec.Network.PublicGateway.IP == nil {
-		ip, err := s.ClusterScope.ScalewayClient.FindGatewayIPByTags(ctx, zone, s.ClusterScope.Tags())
+	if s.existingIP() == nil {
+		ip, err := s.getIP(ctx)
 		if err != nil && !errors.Is(err, client.ErrNoItemFound) {
 			return fmt.Errorf("failed to find Public Gateway IP: %w", err)
 		}
 
 		if err == nil {
 			if err := s.ClusterScope.ScalewayClient.PublicGateway.DeleteIP(&vpcgw.DeleteIPRequest{
-				Zone: zone,
+				Zone: s.zone,
 				IPID: ip.ID,
-			}); err != nil {
+			}); err != nil && !client.IsNotFoundError(err) {
 				return fmt.Errorf("failed to delete Public Gateway IP: %w", err)
 			}
 		}
@@ -0,0 +1,232 @@
+package vpcgw
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/Tomy2e/cluster-api-provider-scaleway/api/v1beta1"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/scope"
+	"github.com/Tomy2e/cluster-api-provider-scaleway/internal/service/scaleway/client/fake"
+	"github.com/scaleway/scaleway-sdk-go/api/vpcgw/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func TestEnsureDefaultRoute(t *testing.T) {
+	tests := []struct {
+		name string
+		// gateway statuses by zone, a missing zone makes the API fail.
+		gateways map[scw.Zone]vpcgw.GatewayStatus
+		// status of the gateway network of the main zone.
+		mainNetworkStatus vpcgw.GatewayNetworkStatus
+		want              map[scw.Zone]bool
+	}{
+		{
+			name:              "main Public Gateway is healthy",
+			gateways:          map[scw.Zone]vpcgw.GatewayStatus{scw.ZoneFrPar1: vpcgw.GatewayStatusRunning, scw.ZoneFrPar2: vpcgw.GatewayStatusRunning},
+			mainNetworkStatus: vpcgw.GatewayNetworkStatusReady,
+			want:              map[scw.Zone]bool{scw.ZoneFrPar1: true, scw.ZoneFrPar2: false},
+		},
+		{
+			name:              "main Public Gateway is stopped",
+			gateways:          map[scw.Zone]vpcgw.GatewayStatus{scw.ZoneFrPar1: vpcgw.GatewayStatusStopped, scw.ZoneFrPar2: vpcgw.GatewayStatusRunning},
+			mainNetworkStatus: vpcgw.GatewayNetworkStatusReady,
+			want:              map[scw.Zone]bool{scw.ZoneFrPar1: false, scw.ZoneFrPar2: true},
+		},
+		{
+			name:              "main Gateway Network is not ready",
+			gateways:          map[scw.Zone]vpcgw.GatewayStatus{scw.ZoneFrPar1: vpcgw.GatewayStatusRunning, scw.ZoneFrPar2: vpcgw.GatewayStatusRunning},
+			mainNetworkStatus: vpcgw.GatewayNetworkStatusConfiguring,
+			want:              map[scw.Zone]bool{scw.ZoneFrPar1: false, scw.ZoneFrPar2: true},
+		},
+		{
+			name:              "main Public Gateway cannot be retrieved",
+			gateways:          map[scw.Zone]vpcgw.GatewayStatus{scw.ZoneFrPar2: vpcgw.GatewayStatusRunning},
+			mainNetworkStatus: vpcgw.GatewayNetworkStatusReady,
+			want:              map[scw.Zone]bool{scw.ZoneFrPar1: false, scw.ZoneFrPar2: true},
+		},
+		{
+			name:              "no healthy Public Gateway",
+			gateways:          map[scw.Zone]vpcgw.GatewayStatus{scw.ZoneFrPar1: vpcgw.GatewayStatusStopped, scw.ZoneFrPar2: vpcgw.GatewayStatusStopped},
+			mainNetworkStatus: vpcgw.GatewayNetworkStatusReady,
+			want:              map[scw.Zone]bool{scw.ZoneFrPar1: true, scw.ZoneFrPar2: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := fake.NewAPI()
+
+			// The service and the fake API do not share the same objects.
+			pushDefaultRoute := make(map[scw.Zone]bool)
+
+			var gwNetworks []*vpcgw.GatewayNetwork
+
+			for _, zone := range []scw.Zone{scw.ZoneFrPar1, scw.ZoneFrPar2} {
+				zonePath := "/vpc-gw/v1/zones/" + zone.String()
+
+				if status, ok := tt.gateways[zone]; ok {
+					api.JSON("GET "+zonePath+"/gateways/gw-"+zone.String(), &vpcgw.Gateway{ID: "gw-" + zone.String(), Zone: zone, Status: status})
+				}
+
+				gwNetwork := &vpcgw.GatewayNetwork{
+					ID:         "gwn-" + zone.String(),
+					GatewayID:  "gw-" + zone.String(),
+					Zone:       zone,
+					Status:     vpcgw.GatewayNetworkStatusReady,
+					IpamConfig: &vpcgw.IpamConfig{PushDefaultRoute: zone == scw.ZoneFrPar1},
+				}
+				if zone == scw.ZoneFrPar1 {
+					gwNetwork.Status = tt.mainNetworkStatus
+				}
+
+				pushDefaultRoute[zone] = gwNetwork.IpamConfig.PushDefaultRoute
+
+				api.Handle("PATCH "+zonePath+"/gateway-networks/"+gwNetwork.ID, func(w http.ResponseWriter, r *http.Request) {
+					req := &vpcgw.UpdateGatewayNetworkRequest{}
+					if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+
+					pushDefaultRoute[zone] = *req.IpamConfig.PushDefaultRoute
+					fake.WriteJSON(w, http.StatusOK, &vpcgw.GatewayNetwork{
+						ID:         "gwn-" + zone.String(),
+						Zone:       zone,
+						IpamConfig: &vpcgw.IpamConfig{PushDefaultRoute: pushDefaultRoute[zone]},
+					})
+				})
+
+				gwNetworks = append(gwNetworks, gwNetwork)
+			}
+
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, api),
+				ScalewayCluster: &v1beta1.ScalewayCluster{
+					Spec: v1beta1.ScalewayClusterSpec{
+						Region: "fr-par",
+						Network: &v1beta1.NetworkSpec{
+							PrivateNetwork: &v1beta1.PrivateNetworkSpec{Enabled: true},
+							PublicGateway: &v1beta1.PublicGatewaySpec{
+								Enabled:   true,
+								Zone:      scw.StringPtr(scw.ZoneFrPar1.String()),
+								MultiZone: scw.BoolPtr(true),
+							},
+						},
+					},
+				},
+			})
+
+			if err := s.ensureDefaultRoute(context.Background(), gwNetworks); err != nil {
+				t.Fatalf("ensureDefaultRoute() error = %v", err)
+			}
+
+			for zone, want := range tt.want {
+				if got := pushDefaultRoute[zone]; got != want {
+					t.Errorf("default route of zone %s = %v, want %v", zone, got, want)
+				}
+
+				if got := s.forZone(zone).status().DefaultRoute; got != want {
+					t.Errorf("default route in status of zone %s = %v, want %v", zone, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureBastion(t *testing.T) {
+	const (
+		gatewayID   = "22222222-2222-2222-2222-222222222222"
+		gatewayPath = "/vpc-gw/v1/zones/fr-par-1/gateways/" + gatewayID
+	)
+
+	tests := []struct {
+		name        string
+		bastion     *v1beta1.BastionSpec
+		gateway     vpcgw.Gateway
+		wantRequest *vpcgw.UpdateGatewayRequest
+	}{
+		{
+			name:    "bastion not configured",
+			gateway: vpcgw.Gateway{BastionEnabled: true, BastionPort: 22},
+		},
+		{
+			name:    "enable bastion with default port",
+			bastion: &v1beta1.BastionSpec{Enabled: true},
+			wantRequest: &vpcgw.UpdateGatewayRequest{
+				EnableBastion: scw.BoolPtr(true),
+				BastionPort:   scw.Uint32Ptr(61000),
+			},
+		},
+		{
+			name:    "bastion is up-to-date",
+			bastion: &v1beta1.BastionSpec{Enabled: true, Port: scw.Uint32Ptr(2222)},
+			gateway: vpcgw.Gateway{BastionEnabled: true, BastionPort: 2222},
+		},
+		{
+			name:    "change bastion port",
+			bastion: &v1beta1.BastionSpec{Enabled: true, Port: scw.Uint32Ptr(2222)},
+			gateway: vpcgw.Gateway{BastionEnabled: true, BastionPort: 61000},
+			wantRequest: &vpcgw.UpdateGatewayRequest{
+				EnableBastion: scw.BoolPtr(true),
+				BastionPort:   scw.Uint32Ptr(2222),
+			},
+		},
+		{
+			name:    "disable bastion",
+			bastion: &v1beta1.BastionSpec{Enabled: false},
+			gateway: vpcgw.Gateway{BastionEnabled: true, BastionPort: 61000},
+			wantRequest: &vpcgw.UpdateGatewayRequest{
+				EnableBastion: scw.BoolPtr(false),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *vpcgw.UpdateGatewayRequest
+
+			api := fake.NewAPI()
+			api.Handle("PATCH "+gatewayPath, func(w http.ResponseWriter, r *http.Request) {
+				got = &vpcgw.UpdateGatewayRequest{}
+				if err := json.NewDecoder(r.Body).Decode(got); err != nil {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+
+				fake.WriteJSON(w, http.StatusOK, &vpcgw.Gateway{ID: gatewayID, Zone: scw.ZoneFrPar1})
+			})
+
+			s := NewService(&scope.Cluster{
+				ScalewayClient: fake.NewClient(t, api),
+				ScalewayCluster: &v1beta1.ScalewayCluster{
+					Spec: v1beta1.ScalewayClusterSpec{
+						Region: "fr-par",
+						Network: &v1beta1.NetworkSpec{
+							PrivateNetwork: &v1beta1.PrivateNetworkSpec{Enabled: true},
+							PublicGateway: &v1beta1.PublicGatewaySpec{
+								Enabled: true,
+								Zone:    scw.StringPtr(scw.ZoneFrPar1.String()),
+								Bastion: tt.bastion,
+							},
+						},
+					},
+				},
+			})
+
+			gw := tt.gateway
+			gw.ID = gatewayID
+			gw.Zone = scw.ZoneFrPar1
+
+			if _, err := s.ensureBastion(context.Background(), &gw); err != nil {
+				t.Fatalf("ensureBastion() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.wantRequest) {
+				t.Errorf("ensureBastion() request = %+v, want %+v", got, tt.wantRequest)
+			}
+		})
+	}
+}